@@ -39,7 +39,10 @@ func init() {
 	rootCmd.PersistentFlags().StringArrayVar(&options.ExtraKernelArgs, "extra-kernel-arg", []string{}, "Extra argument to pass to the kernel")
 	rootCmd.PersistentFlags().BoolVar(&options.Bootloader, "bootloader", true, "Install a booloader to the specified disk")
 	rootCmd.PersistentFlags().BoolVar(&options.Upgrade, "upgrade", false, "Indicates that the install is being performed by an upgrade")
+	rootCmd.PersistentFlags().BoolVar(&options.Stage, "stage", false, "Indicates that the upgrade is installed while the system is running, the system partitions are not mounted")
 	rootCmd.PersistentFlags().BoolVar(&options.Force, "force", false, "Indicates that the install should forcefully format the partition")
 	rootCmd.PersistentFlags().BoolVar(&options.Zero, "zero", false, "Indicates that the install should write zeros to the disk before installing")
+	rootCmd.PersistentFlags().StringArrayVar(&options.EncryptedPartitions, "encrypted-partition", []string{}, "The label of a partition which is encrypted on the first boot, it is left unformatted")
+	rootCmd.PersistentFlags().IntVar(&options.BootAttempts, "boot-attempts", constants.DefaultUpgradeBootAttempts, "The number of the attempts to boot the upgraded image before falling back to the previous one")
 	rootCmd.PersistentFlags().BoolVar(&options.Save, "save", false, "Indicates that the install should write the config to disk (only supports file:// scheme)")
 }
@@ -21,6 +21,8 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/grub"
+	"github.com/talos-systems/talos/internal/pkg/kernel"
+	"github.com/talos-systems/talos/internal/pkg/meta"
 	"github.com/talos-systems/talos/internal/pkg/mount"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/version"
@@ -34,9 +36,13 @@ type Options struct {
 	ExtraKernelArgs []string
 	Bootloader      bool
 	Upgrade         bool
+	Stage           bool
 	Force           bool
 	Zero            bool
 	Save            bool
+	BootAttempts    int
+
+	EncryptedPartitions []string
 }
 
 // Install installs Talos.
@@ -55,6 +61,12 @@ func Install(p runtime.Platform, seq runtime.Sequence, opts *Options) (err error
 
 	cmdline.AppendDefaults()
 
+	if seq == runtime.SequenceUpgrade {
+		if cmdline, err = withPersistedKernelArgs(cmdline); err != nil {
+			return err
+		}
+	}
+
 	i, err := NewInstaller(cmdline, seq, opts)
 	if err != nil {
 		return err
@@ -69,6 +81,29 @@ func Install(p runtime.Platform, seq runtime.Sequence, opts *Options) (err error
 	return nil
 }
 
+// withPersistedKernelArgs applies the kernel args updated via the API, so
+// that they are carried over by the upgrade.
+func withPersistedKernelArgs(cmdline *procfs.Cmdline) (*procfs.Cmdline, error) {
+	values, err := meta.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	set, remove := values.KernelArgs()
+
+	if len(set) == 0 && len(remove) == 0 {
+		return cmdline, nil
+	}
+
+	args := kernel.UpdateArgs(cmdline.String(), set, remove)
+
+	if err = kernel.ValidateArgs(args); err != nil {
+		return nil, fmt.Errorf("invalid kernel args updates: %w", err)
+	}
+
+	return procfs.NewCmdline(args), nil
+}
+
 // Installer represents the installer logic. It serves as the entrypoint to all
 // installation methods.
 type Installer struct {
@@ -125,6 +160,14 @@ func NewInstaller(cmdline *procfs.Cmdline, seq runtime.Sequence, opts *Options)
 //
 // nolint: gocyclo
 func (i *Installer) Install(seq runtime.Sequence) (err error) {
+	if i.options.Save {
+		for _, label := range i.options.EncryptedPartitions {
+			if label == constants.StatePartitionLabel {
+				return fmt.Errorf("the config can't be saved to the encrypted %s partition", label)
+			}
+		}
+	}
+
 	if i.options.Force {
 		if i.bootPartitionFound {
 			var dev *probe.ProbedBlockDevice
@@ -183,8 +226,10 @@ func (i *Installer) Install(seq runtime.Sequence) (err error) {
 		//nolint: errcheck
 		defer meta.Close()
 
-		if ok := meta.SetTag(bootloader.AdvUpgrade, i.Current); !ok {
-			return fmt.Errorf("failed to set upgrade tag: %q", i.Current)
+		// The new label is booted on trial, the node falls back to the
+		// current label unless machined confirms the upgrade.
+		if ok := meta.StartTrial(i.Current, i.Next); !ok {
+			return fmt.Errorf("failed to set upgrade tags: %q, %q", i.Current, i.Next)
 		}
 
 		if _, err = meta.Write(); err != nil {
@@ -196,10 +241,18 @@ func (i *Installer) Install(seq runtime.Sequence) (err error) {
 
 	mountpoints := mount.NewMountPoints()
 
+	skip := append([]string(nil), i.options.EncryptedPartitions...)
+
+	// The system partitions are in use by the running system when an upgrade
+	// is staged, and only the boot partitions are written to.
+	if i.options.Stage {
+		skip = append(skip, constants.StatePartitionLabel, constants.EphemeralPartitionLabel)
+	}
+
 	for dev := range i.manifest.Targets {
 		var mp *mount.Points
 
-		mp, err = mount.SystemMountPointsForDevice(dev)
+		mp, err = mount.SystemMountPointsForDevice(dev, skip...)
 		if err != nil {
 			return err
 		}
@@ -255,6 +308,12 @@ func (i *Installer) Install(seq runtime.Sequence) (err error) {
 	if i.bootPartitionFound && i.Current != "" {
 		grubcfg.Fallback = i.Current
 
+		// The upgraded label is booted on trial, grub falls back to the
+		// current label once the boot attempts are exhausted.
+		if seq == runtime.SequenceUpgrade {
+			grubcfg.BootAttempts = i.options.BootAttempts
+		}
+
 		grubcfg.Labels = append(grubcfg.Labels, &grub.Label{
 			Root:   i.Current,
 			Initrd: filepath.Join("/", i.Current, constants.InitramfsAsset),
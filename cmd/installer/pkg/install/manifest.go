@@ -5,6 +5,7 @@
 package install
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -18,9 +19,9 @@ import (
 	"github.com/talos-systems/go-blockdevice/blockdevice"
 	"github.com/talos-systems/go-blockdevice/blockdevice/table"
 	"github.com/talos-systems/go-blockdevice/blockdevice/table/gpt/partition"
-	"github.com/talos-systems/go-blockdevice/blockdevice/util"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/pkg/raid"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/makefs"
 )
@@ -40,6 +41,7 @@ type Target struct {
 	Size           uint
 	Force          bool
 	Test           bool
+	Encrypted      bool
 	Assets         []*Asset
 	BlockDevice    *blockdevice.BlockDevice
 }
@@ -141,6 +143,18 @@ func NewManifest(label string, sequence runtime.Sequence, opts *Options) (manife
 		Test:   false,
 	}
 
+	// The encrypted partitions are formatted on the first boot.
+	for _, label := range opts.EncryptedPartitions {
+		switch label {
+		case constants.StatePartitionLabel:
+			stateTarget.Encrypted = true
+		case constants.EphemeralPartitionLabel:
+			ephemeralTarget.Encrypted = true
+		default:
+			return nil, fmt.Errorf("encryption is not supported for partition %q", label)
+		}
+	}
+
 	for _, target := range []*Target{efiTarget, biosTarget, bootTarget, metaTarget, stateTarget, ephemeralTarget} {
 		if target == nil {
 			continue
@@ -180,7 +194,7 @@ func (m *Manifest) ExecuteManifest() (err error) {
 			err = retry.Constant(time.Minute, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
 				e := target.Format()
 				if e != nil {
-					if strings.Contains(e.Error(), "No such file or directory") {
+					if strings.Contains(e.Error(), "No such file or directory") || errors.Is(e, os.ErrNotExist) {
 						// workaround problem with partition device not being visible immediately after partitioning
 						return retry.ExpectedError(e)
 					}
@@ -235,6 +249,10 @@ func (t *Target) Partition(bd *blockdevice.BlockDevice) (err error) {
 	default:
 		opts = append(opts, partition.WithPartitionType(LinuxFilesystemData))
 
+		if t.Label != "" {
+			opts = append(opts, partition.WithPartitionName(t.Label))
+		}
+
 		if t.Size == 0 {
 			opts = append(opts, partition.WithMaximumSize(true))
 		}
@@ -249,7 +267,7 @@ func (t *Target) Partition(bd *blockdevice.BlockDevice) (err error) {
 		return err
 	}
 
-	t.PartitionName, err = util.PartPath(t.Device, int(part.No()))
+	t.PartitionName, err = raid.PartPath(t.Device, int(part.No()))
 	if err != nil {
 		return err
 	}
@@ -261,6 +279,12 @@ func (t *Target) Partition(bd *blockdevice.BlockDevice) (err error) {
 //
 //nolint: gocyclo
 func (t *Target) Format() error {
+	if t.Encrypted {
+		log.Printf("wiping partition %q with label %q to be encrypted on boot\n", t.PartitionName, t.Label)
+
+		return wipe(t.PartitionName, encryptionHeaderSize)
+	}
+
 	switch t.Label {
 	case constants.EFIPartitionLabel:
 		log.Printf("formatting partition %q as %q with label %q\n", t.PartitionName, "fat", t.Label)
@@ -297,8 +321,55 @@ func (t *Target) Format() error {
 
 		return makefs.XFS(t.PartitionName, opts...)
 	default:
+		return t.formatUserPartition()
+	}
+}
+
+// formatUserPartition creates the filesystem of the user disk partition.
+func (t *Target) formatUserPartition() error {
+	if t.FileSystemType == "" {
 		return nil
 	}
+
+	log.Printf("formatting partition %q as %q with label %q\n", t.PartitionName, t.FileSystemType, t.Label)
+
+	opts := []makefs.Option{makefs.WithForce(t.Force)}
+
+	if t.Label != "" {
+		opts = append(opts, makefs.WithLabel(t.Label))
+	}
+
+	switch t.FileSystemType {
+	case "xfs":
+		return makefs.XFS(t.PartitionName, opts...)
+	case "ext4":
+		return makefs.Ext4(t.PartitionName, opts...)
+	case "vfat":
+		return makefs.VFAT(t.PartitionName, opts...)
+	default:
+		return fmt.Errorf("unsupported filesystem %q", t.FileSystemType)
+	}
+}
+
+// encryptionHeaderSize is the size of the default LUKS2 header.
+const encryptionHeaderSize = 16 * 1024 * 1024
+
+// wipe zeroes the beginning of the partition, so that neither a stale
+// filesystem nor an encryption header is found on it.
+func wipe(partname string, size int) error {
+	f, err := os.OpenFile(partname, os.O_WRONLY, os.ModeDevice)
+	if err != nil {
+		return err
+	}
+
+	// nolint: errcheck
+	defer f.Close()
+
+	if _, err = f.Write(make([]byte, size)); err != nil {
+		return err
+	}
+
+	return f.Close()
 }
 
 // Save copies the assets to the bootloader partition.
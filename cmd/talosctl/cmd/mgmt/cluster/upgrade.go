@@ -0,0 +1,157 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/cmd/talosctl/cmd/talos"
+	"github.com/talos-systems/talos/pkg/cluster"
+	"github.com/talos-systems/talos/pkg/cluster/upgrade"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
+)
+
+// upgradeCmd represents the cluster upgrade command.
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "Upgrade Talos on all the nodes of the cluster one batch at a time",
+	Long: `Worker nodes are upgraded first, then control plane nodes one by one to preserve etcd quorum.
+Each batch of nodes has to reboot and pass the cluster health checks before the upgrade moves on.
+
+The upgrade progress is saved to the state file: an upgrade interrupted with Ctrl+C or paused
+with --pause-after can be continued with --resume.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return talos.WithClientNoNodes(upgradeCluster)
+	},
+}
+
+var upgradeCmdFlags struct {
+	image          string
+	preserve       bool
+	maxUnavailable int
+	pauseAfter     int
+	resume         bool
+	statePath      string
+	nodeTimeout    time.Duration
+	healthTimeout  time.Duration
+	forceEndpoint  string
+	controlPlane   []string
+	workers        []string
+}
+
+func upgradeCluster(ctx context.Context, c *client.Client) error {
+	clientProvider := &cluster.ConfigClientProvider{
+		DefaultClient: c,
+	}
+	defer clientProvider.Close() //nolint: errcheck
+
+	k8sProvider := &cluster.KubernetesClient{
+		ClientProvider: clientProvider,
+		ForceEndpoint:  upgradeCmdFlags.forceEndpoint,
+	}
+
+	state := &upgrade.State{}
+
+	if upgradeCmdFlags.resume {
+		var err error
+
+		if state, err = upgrade.LoadState(upgradeCmdFlags.statePath); err != nil {
+			return err
+		}
+	}
+
+	nodes := &upgrade.Nodes{
+		ControlPlaneNodes: upgradeCmdFlags.controlPlane,
+		WorkerNodes:       upgradeCmdFlags.workers,
+	}
+
+	// the nodes which weren't specified are discovered
+	if len(nodes.ControlPlaneNodes) == 0 || len(nodes.WorkerNodes) == 0 {
+		discovered, err := upgrade.DiscoverNodes(ctx, k8sProvider)
+		if err != nil {
+			return fmt.Errorf("error discovering cluster nodes: %w", err)
+		}
+
+		if len(nodes.ControlPlaneNodes) == 0 {
+			nodes.ControlPlaneNodes = discovered.ControlPlaneNodes
+		}
+
+		if len(nodes.WorkerNodes) == 0 {
+			nodes.WorkerNodes = discovered.WorkerNodes
+		}
+	}
+
+	options := upgrade.DefaultOptions()
+	options.Image = upgradeCmdFlags.image
+	options.Preserve = upgradeCmdFlags.preserve
+	options.MaxUnavailable = upgradeCmdFlags.maxUnavailable
+	options.PauseAfter = upgradeCmdFlags.pauseAfter
+	options.NodeTimeout = upgradeCmdFlags.nodeTimeout
+	options.HealthTimeout = upgradeCmdFlags.healthTimeout
+	options.StatePath = upgradeCmdFlags.statePath
+
+	// the image of the resumed upgrade is taken from the state
+	if options.Image == "" {
+		options.Image = state.Image
+	}
+
+	info := struct {
+		cluster.ClientProvider
+		cluster.K8sProvider
+		cluster.Info
+	}{
+		ClientProvider: clientProvider,
+		K8sProvider:    k8sProvider,
+		Info:           nodes,
+	}
+
+	err := upgrade.Run(ctx, &info, state, options)
+
+	switch {
+	case err == nil:
+		fmt.Fprintln(os.Stderr, "cluster upgrade finished")
+
+		if err = os.Remove(upgradeCmdFlags.statePath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		return nil
+	case errors.Is(err, upgrade.ErrPaused), errors.Is(err, context.Canceled):
+		fmt.Fprintf(os.Stderr, "cluster upgrade paused, run with --resume to continue\n")
+
+		return nil
+	default:
+		return err
+	}
+}
+
+func init() {
+	defaultStatePath, err := clientconfig.GetTalosDirectory()
+	if err == nil {
+		defaultStatePath = filepath.Join(defaultStatePath, "upgrade.yaml")
+	}
+
+	upgradeCmd.Flags().StringVarP(&upgradeCmdFlags.image, "image", "i", "", "the container image to use for performing the install")
+	upgradeCmd.Flags().BoolVarP(&upgradeCmdFlags.preserve, "preserve", "p", false, "preserve data")
+	upgradeCmd.Flags().IntVar(&upgradeCmdFlags.maxUnavailable, "max-unavailable", 1, "the number of worker nodes upgraded at the same time")
+	upgradeCmd.Flags().IntVar(&upgradeCmdFlags.pauseAfter, "pause-after", 0, "pause the upgrade after upgrading the number of nodes")
+	upgradeCmd.Flags().BoolVar(&upgradeCmdFlags.resume, "resume", false, "resume the paused upgrade from the state file")
+	upgradeCmd.Flags().StringVar(&upgradeCmdFlags.statePath, "upgrade-state", defaultStatePath, "the file to save the upgrade progress to")
+	upgradeCmd.Flags().DurationVar(&upgradeCmdFlags.nodeTimeout, "node-timeout", upgrade.DefaultOptions().NodeTimeout, "timeout to wait for a node to reboot")
+	upgradeCmd.Flags().DurationVar(&upgradeCmdFlags.healthTimeout, "wait-timeout", upgrade.DefaultOptions().HealthTimeout, "timeout to wait for the cluster to be healthy after each batch")
+	upgradeCmd.Flags().StringVar(&upgradeCmdFlags.forceEndpoint, "k8s-endpoint", "", "use endpoint instead of kubeconfig default")
+	upgradeCmd.Flags().StringSliceVar(&upgradeCmdFlags.controlPlane, "control-plane-nodes", nil, "specify IPs of control plane nodes, discovered from Kubernetes by default")
+	upgradeCmd.Flags().StringSliceVar(&upgradeCmdFlags.workers, "worker-nodes", nil, "specify IPs of worker nodes, discovered from Kubernetes by default")
+	Cmd.AddCommand(upgradeCmd)
+}
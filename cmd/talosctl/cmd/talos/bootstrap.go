@@ -7,21 +7,47 @@ package talos
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/spf13/cobra"
 
+	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/talos-systems/talos/internal/pkg/etcd"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
 )
 
+var bootstrapCmdFlags struct {
+	recoverFrom          string
+	recoverSkipHashCheck bool
+}
+
 // bootstrapCmd represents the bootstrap command.
 var bootstrapCmd = &cobra.Command{
 	Use:   "bootstrap",
 	Short: "Bootstrap the cluster",
-	Long:  ``,
-	Args:  cobra.NoArgs,
+	Long: `Bootstrap the cluster.
+
+With --recover-from, etcd is recovered from the snapshot taken with 'talosctl etcd snapshot'.
+The snapshot copied from the etcd data directory has no integrity hash, it requires --recover-skip-hash-check.`,
+	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return WithClient(func(ctx context.Context, c *client.Client) error {
-			if err := c.Bootstrap(ctx); err != nil {
+			if bootstrapCmdFlags.recoverFrom != "" {
+				if err := helpers.FailIfMultiNodes(ctx, "bootstrap"); err != nil {
+					return err
+				}
+
+				if err := recoverEtcd(ctx, c); err != nil {
+					return err
+				}
+			}
+
+			if err := c.BootstrapWithOptions(ctx, &machineapi.BootstrapRequest{
+				RecoverEtcd:          bootstrapCmdFlags.recoverFrom != "",
+				RecoverSkipHashCheck: bootstrapCmdFlags.recoverSkipHashCheck,
+			}); err != nil {
 				return fmt.Errorf("error executing bootstrap: %s", err)
 			}
 
@@ -30,6 +56,34 @@ var bootstrapCmd = &cobra.Command{
 	},
 }
 
+// recoverEtcd verifies the snapshot and uploads it to the node.
+func recoverEtcd(ctx context.Context, c *client.Client) error {
+	snapshot, err := os.Open(bootstrapCmdFlags.recoverFrom)
+	if err != nil {
+		return fmt.Errorf("error opening snapshot: %w", err)
+	}
+
+	defer snapshot.Close() //nolint: errcheck
+
+	if !bootstrapCmdFlags.recoverSkipHashCheck {
+		if err = etcd.VerifySnapshot(snapshot); err != nil {
+			return fmt.Errorf("error verifying snapshot: %w", err)
+		}
+
+		if _, err = snapshot.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	}
+
+	if _, err = c.EtcdRecover(ctx, snapshot); err != nil {
+		return fmt.Errorf("error uploading snapshot: %w", err)
+	}
+
+	return nil
+}
+
 func init() {
+	bootstrapCmd.Flags().StringVar(&bootstrapCmdFlags.recoverFrom, "recover-from", "", "recover etcd cluster from the snapshot")
+	bootstrapCmd.Flags().BoolVar(&bootstrapCmdFlags.recoverSkipHashCheck, "recover-skip-hash-check", false, "skip integrity check when recovering etcd (use when recovering from data directory copy)")
 	addCommand(bootstrapCmd)
 }
@@ -0,0 +1,211 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/spf13/cobra"
+	"github.com/talos-systems/go-retry/retry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/talos-systems/talos/internal/pkg/certificates"
+	"github.com/talos-systems/talos/pkg/cli"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+var certificatesCmdFlags struct {
+	kubeconfig string
+}
+
+// certificatesCmd represents the certificates command.
+var certificatesCmd = &cobra.Command{
+	Use:     "certificates",
+	Aliases: []string{"certs"},
+	Short:   "List the certificates Talos manages on the nodes",
+	Long: `List the certificates Talos manages on the nodes with their expiry.
+
+The client certificates of the admin kubeconfig are issued on request, use --kubeconfig to list them as well.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.CertificateList(ctx, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error listing certificates: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tNAME\tPATH\tSUBJECT\tNOT AFTER\tAUTO RENEWED")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				for _, crt := range msg.Certificates {
+					notAfter, err := ptypes.Timestamp(crt.NotAfter)
+					if err != nil {
+						return err
+					}
+
+					printCertificate(w, node, crt.Name, crt.Path, crt.Subject, notAfter, crt.AutoRenewed)
+				}
+			}
+
+			if certificatesCmdFlags.kubeconfig != "" {
+				if err = printKubeconfigCertificates(w, certificatesCmdFlags.kubeconfig); err != nil {
+					return err
+				}
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+func printCertificate(w io.Writer, node, name, path, subject string, notAfter time.Time, autoRenewed bool) {
+	if path == "" {
+		path = "-"
+	}
+
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%v\n", node, name, path, subject, notAfter.Format(time.RFC3339), autoRenewed)
+}
+
+func printKubeconfigCertificates(w io.Writer, path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error loading kubeconfig: %w", err)
+	}
+
+	certs, err := certificates.ParseKubeconfig(path, data)
+	if err != nil {
+		return err
+	}
+
+	for _, crt := range certs {
+		printCertificate(w, "local", crt.Name, crt.Path, crt.Subject.String(), crt.NotAfter, false)
+	}
+
+	return nil
+}
+
+// certificatesRotateCmd represents the certificates rotate command.
+var certificatesRotateCmd = &cobra.Command{
+	Use:   "rotate",
+	Short: "Re-issue the certificates Talos manages on the nodes",
+	Long: `Re-issue the leaf certificates Talos manages on the nodes from the CAs in the machine config.
+
+The services using the certificates are restarted, so the nodes are rotated one at a time,
+and the next node is rotated once apid of the previous one is back.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			for _, node := range Nodes {
+				nodeCtx := client.WithNodes(ctx, node)
+
+				_, since, err := apidState(nodeCtx, c)
+				if err != nil {
+					return fmt.Errorf("error getting apid state on %s: %w", node, err)
+				}
+
+				resp, err := c.CertificateRotate(nodeCtx, &machineapi.CertificateRotateRequest{
+					Force: certificatesRotateCmdFlags.force,
+				})
+				if err != nil {
+					return fmt.Errorf("error rotating certificates on %s: %w", node, err)
+				}
+
+				for _, msg := range resp.Messages {
+					fmt.Printf("%s: restarted %s\n", node, strings.Join(msg.Services, ", "))
+				}
+
+				// the requests to the next nodes might be proxied via the
+				// node, so its apid should be back first
+				if err = waitAPIDRestarted(nodeCtx, c, since); err != nil {
+					return fmt.Errorf("error waiting for apid on %s: %w", node, err)
+				}
+			}
+
+			return nil
+		})
+	},
+}
+
+var certificatesRotateCmdFlags struct {
+	force bool
+}
+
+// apidRestartTimeout is the time to wait for apid to come back after the
+// rotation.
+const apidRestartTimeout = 2 * time.Minute
+
+// apidState returns the apid service state and the time of its last event,
+// as reported by the node clock.
+func apidState(ctx context.Context, c *client.Client) (state string, last time.Time, err error) {
+	services, err := c.ServiceInfo(ctx, "apid")
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	if len(services) == 0 {
+		return "", time.Time{}, fmt.Errorf("service apid is not found")
+	}
+
+	events := services[0].Service.GetEvents().GetEvents()
+	if len(events) > 0 {
+		if last, err = ptypes.Timestamp(events[len(events)-1].GetTs()); err != nil {
+			return "", time.Time{}, err
+		}
+	}
+
+	return services[0].Service.GetState(), last, nil
+}
+
+// waitAPIDRestarted waits for apid to be running once again after the event
+// at since.
+func waitAPIDRestarted(ctx context.Context, c *client.Client, since time.Time) error {
+	return retry.Constant(apidRestartTimeout, retry.WithUnits(time.Second)).Retry(func() error {
+		state, last, err := apidState(ctx, c)
+		if err != nil {
+			// apid is unavailable while it restarts
+			return retry.ExpectedError(err)
+		}
+
+		if state != "Running" || !last.After(since) {
+			return retry.ExpectedError(fmt.Errorf("apid hasn't restarted yet"))
+		}
+
+		return nil
+	})
+}
+
+func init() {
+	certificatesCmd.Flags().StringVar(&certificatesCmdFlags.kubeconfig, "kubeconfig", "", "list the client certificates of the kubeconfig as well")
+
+	certificatesRotateCmd.Flags().BoolVar(&certificatesRotateCmdFlags.force, "force", false, "restart etcd even if the cluster loses the quorum while it restarts")
+
+	certificatesCmd.AddCommand(certificatesRotateCmd)
+	addCommand(certificatesCmd)
+}
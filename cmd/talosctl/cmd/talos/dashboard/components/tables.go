@@ -14,6 +14,7 @@ import (
 	"github.com/dustin/go-humanize"
 	ui "github.com/gizak/termui/v3"
 	"github.com/gizak/termui/v3/widgets"
+	"github.com/golang/protobuf/ptypes"
 
 	"github.com/talos-systems/talos/cmd/talosctl/cmd/talos/dashboard/data"
 )
@@ -104,3 +105,88 @@ func (widget *ProcessTable) Update(node string, data *data.Data) {
 		}
 	}
 }
+
+// ServiceTable represents the widget with service info.
+type ServiceTable struct {
+	widgets.List
+}
+
+// NewServiceTable initializes ServiceTable.
+func NewServiceTable() *ServiceTable {
+	widget := &ServiceTable{
+		List: *widgets.NewList(),
+	}
+
+	widget.Border = false
+	widget.Title = fmt.Sprintf("%-20s  %-10s  %6s  %7s  %6s  %8s  %8s  %10s",
+		"SERVICE",
+		"STATE",
+		"HEALTH",
+		"PID",
+		"CPU%",
+		"MEM",
+		"RESTARTS",
+		"UPTIME",
+	)
+	widget.Rows = []string{
+		noData,
+	}
+
+	return widget
+}
+
+// Update implements the DataWidget interface.
+func (widget *ServiceTable) Update(node string, data *data.Data) {
+	nodeData := data.Nodes[node]
+
+	if nodeData == nil {
+		widget.Rows = []string{
+			noData,
+		}
+
+		return
+	}
+
+	widget.Rows = widget.Rows[:0]
+
+	interval := data.Interval
+	if interval == 0 {
+		interval = time.Second
+	}
+
+	for _, svc := range nodeData.Services.GetServices() {
+		health := "?"
+
+		switch {
+		case svc.GetHealth().GetUnknown():
+		case svc.GetHealth().GetHealthy():
+			health = "OK"
+		default:
+			health = "Fail"
+		}
+
+		pid, uptime := "-", "-"
+
+		stats := svc.GetStats()
+
+		if stats.GetPid() != 0 {
+			pid = fmt.Sprintf("%d", stats.GetPid())
+
+			// nolint: errcheck
+			started, _ := ptypes.Timestamp(stats.GetStarted())
+			uptime = time.Since(started).Round(time.Second).String()
+		}
+
+		line := fmt.Sprintf("%-20s  %-10s  %6s  %7s  %6.1f  %8s  %8d  %10s",
+			svc.Id,
+			svc.State,
+			health,
+			pid,
+			float64(nodeData.ServicesDiff[svc.Id].GetCpuUsage())/float64(interval)*100.0,
+			humanize.Bytes(stats.GetMemoryUsage()),
+			stats.GetRestarts(),
+			uptime,
+		)
+		widget.Rows = append(widget.Rows, line)
+	}
+}
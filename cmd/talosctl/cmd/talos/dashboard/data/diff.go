@@ -83,3 +83,13 @@ func procDiff(old, new *machine.ProcessInfo) *machine.ProcessInfo {
 		CpuTime: new.CpuTime - old.CpuTime,
 	}
 }
+
+func serviceStatsDiff(old, new *machine.ServiceStats) *machine.ServiceStats {
+	if old == nil || new == nil || old.Pid != new.Pid || new.CpuUsage < old.CpuUsage {
+		return &machine.ServiceStats{}
+	}
+
+	return &machine.ServiceStats{
+		CpuUsage: new.CpuUsage - old.CpuUsage,
+	}
+}
@@ -18,12 +18,14 @@ type Node struct {
 	NetDevStats *machine.NetworkDeviceStats
 	DiskStats   *machine.DiskStats
 	Processes   *machine.Process
+	Services    *machine.ServiceList
 
 	// These fields are calculated as diff with Node data from previous pol.
 	SystemStatDiff  *machine.SystemStat
 	NetDevStatsDiff *machine.NetworkDeviceStats
 	DiskStatsDiff   *machine.DiskStats
 	ProcsDiff       map[int32]*machine.ProcessInfo
+	ServicesDiff    map[string]*machine.ServiceStats
 
 	// Time-series data.
 	Series map[string][]float64
@@ -197,4 +199,16 @@ func (node *Node) UpdateDiff(old *Node) {
 	for _, proc := range node.Processes.GetProcesses() {
 		node.ProcsDiff[proc.Pid] = procDiff(index[proc.Pid], proc)
 	}
+
+	node.ServicesDiff = make(map[string]*machine.ServiceStats)
+
+	svcIndex := make(map[string]*machine.ServiceStats)
+
+	for _, svc := range old.Services.GetServices() {
+		svcIndex[svc.Id] = svc.Stats
+	}
+
+	for _, svc := range node.Services.GetServices() {
+		node.ServicesDiff[svc.Id] = serviceStatsDiff(svcIndex[svc.Id], svc.Stats)
+	}
 }
@@ -272,6 +272,27 @@ func (source *APISource) gather() *data.Data {
 				result.Nodes[node].Processes = msg
 			}
 
+			return nil
+		},
+		func() error {
+			resp, err := source.MachineClient.ServiceList(source.ctx, &empty.Empty{})
+			if err != nil {
+				return err
+			}
+
+			resultLock.Lock()
+			defer resultLock.Unlock()
+
+			for _, msg := range resp.GetMessages() {
+				node := msg.GetMetadata().GetHostname()
+
+				if _, ok := result.Nodes[node]; !ok {
+					result.Nodes[node] = &data.Node{}
+				}
+
+				result.Nodes[node].Services = msg
+			}
+
 			return nil
 		},
 	}
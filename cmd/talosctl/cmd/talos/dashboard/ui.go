@@ -36,6 +36,7 @@ type UI struct {
 	netSparkline  *components.BaseSparklineGroup
 	diskSparkline *components.BaseSparklineGroup
 	procTable     *components.ProcessTable
+	svcTable      *components.ServiceTable
 
 	topLine *components.TopLine
 	tabs    *components.NodeTabs
@@ -73,6 +74,7 @@ func (u *UI) Main(ctx context.Context, dataCh <-chan *data.Data) error {
 	u.netSparkline = components.NewNetSparkline()
 	u.diskSparkline = components.NewDiskSparkline()
 	u.procTable = components.NewProcessTable()
+	u.svcTable = components.NewServiceTable()
 
 	u.infoGrid = ui.NewGrid()
 	u.infoGrid.Set(
@@ -97,7 +99,10 @@ func (u *UI) Main(ctx context.Context, dataCh <-chan *data.Data) error {
 				ui.NewRow(1.0/2, u.netSparkline),
 				ui.NewRow(1.0/2, u.diskSparkline),
 			),
-			ui.NewCol(3.0/4, u.procTable),
+			ui.NewCol(3.0/4,
+				ui.NewRow(2.0/3, u.procTable),
+				ui.NewRow(1.0/3, u.svcTable),
+			),
 		),
 	)
 
@@ -117,6 +122,7 @@ func (u *UI) Main(ctx context.Context, dataCh <-chan *data.Data) error {
 		u.netSparkline,
 		u.diskSparkline,
 		u.procTable,
+		u.svcTable,
 	}
 
 	u.drawable = []ui.Drawable{u.topLine, u.infoGrid, u.grid, u.tabs}
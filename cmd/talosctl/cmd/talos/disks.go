@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/talos-systems/talos/pkg/cli"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/disk"
+)
+
+// disksCmd represents the disks command.
+var disksCmd = &cobra.Command{
+	Use:     "disks",
+	Aliases: []string{"disk"},
+	Short:   "List block devices",
+	Long:    `Lists the block devices of the node with their partitions and detected filesystems.`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.Disks(ctx, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error getting disks: %s", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			return disksRender(&remotePeer, resp)
+		})
+	},
+}
+
+func disksRender(remotePeer *peer.Peer, resp *machineapi.DisksResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NODE\tDEV\tMODEL\tSERIAL\tTYPE\tSIZE\tTABLE\tOWNER\tLABEL\tPART TYPE\tFS")
+
+	defaultNode := client.AddrFromPeer(remotePeer)
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		for _, d := range msg.Devices {
+			owner := ""
+			if d.Owner != machineapi.BlockDevice_NONE {
+				owner = strings.ToLower(d.Owner.String())
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				node, d.DeviceName, orDash(d.Model), orDash(d.Serial), d.Type, disk.FormatSize(d.Size), orDash(d.PartitionTable), orDash(owner), "-", "-", filesystemString(d.Filesystem))
+
+			if d.Error != "" {
+				cli.Warning("%s: %s: %s", node, d.DeviceName, d.Error)
+			}
+
+			for _, p := range d.Partitions {
+				owner = ""
+				if p.Owned {
+					owner = "system"
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+					node, p.DeviceName, "-", "-", "-", disk.FormatSize(p.Size), "-", orDash(owner), orDash(p.Label), partitionType(p), filesystemString(p.Filesystem))
+			}
+		}
+	}
+
+	return w.Flush()
+}
+
+func partitionType(p *machineapi.BlockDevicePartition) string {
+	if p.TypeGuid != "" {
+		return p.TypeGuid
+	}
+
+	return fmt.Sprintf("0x%02x", p.MbrType)
+}
+
+func filesystemString(fs *machineapi.BlockDeviceFilesystem) string {
+	switch {
+	case fs == nil:
+		return "-"
+	case fs.Label != "":
+		return fmt.Sprintf("%s (%s)", fs.Type, fs.Label)
+	default:
+		return fs.Type
+	}
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+
+	return s
+}
+
+func init() {
+	addCommand(disksCmd)
+}
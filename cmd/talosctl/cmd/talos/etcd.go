@@ -0,0 +1,419 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/talos-systems/talos/internal/pkg/etcd"
+	"github.com/talos-systems/talos/pkg/cli"
+	"github.com/talos-systems/talos/pkg/machinery/api/common"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+// etcdCmd represents the etcd command.
+var etcdCmd = &cobra.Command{
+	Use:   "etcd",
+	Short: "Manage etcd",
+	Long:  ``,
+}
+
+// etcdSnapshotCmd represents the etcd snapshot command.
+var etcdSnapshotCmd = &cobra.Command{
+	Use:   "snapshot <path>",
+	Short: "Stream snapshot of the etcd node to the path.",
+	Long: `Stream snapshot of the etcd node to the path.
+
+The integrity hash of the snapshot is verified once it is downloaded.
+The snapshot can be restored with 'talosctl bootstrap --recover-from'.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "etcd snapshot"); err != nil {
+				return err
+			}
+
+			dbPath := filepath.Clean(args[0])
+			partPath := dbPath + ".part"
+
+			defer os.RemoveAll(partPath) //nolint: errcheck
+
+			dest, err := os.OpenFile(partPath, os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0o600)
+			if err != nil {
+				return fmt.Errorf("error creating temporary file: %w", err)
+			}
+
+			defer dest.Close() //nolint: errcheck
+
+			r, errCh, err := c.EtcdSnapshot(ctx)
+			if err != nil {
+				return fmt.Errorf("error reading file: %w", err)
+			}
+
+			defer r.Close() //nolint: errcheck
+
+			var wg sync.WaitGroup
+
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				for err := range errCh {
+					fmt.Fprintln(os.Stderr, err.Error())
+				}
+			}()
+
+			defer wg.Wait()
+
+			size, err := io.Copy(dest, r)
+			if err != nil {
+				return fmt.Errorf("error reading: %w", err)
+			}
+
+			if err = etcd.VerifySnapshot(dest); err != nil {
+				return fmt.Errorf("error verifying snapshot: %w", err)
+			}
+
+			if err = dest.Close(); err != nil {
+				return fmt.Errorf("failed to close: %w", err)
+			}
+
+			if err = os.Rename(partPath, dbPath); err != nil {
+				return fmt.Errorf("error renaming to final location: %w", err)
+			}
+
+			fmt.Printf("etcd snapshot saved to %q (%d bytes)\n", dbPath, size)
+
+			return nil
+		})
+	},
+}
+
+var etcdCmdFlags struct {
+	force bool
+}
+
+// etcdMembersCmd represents the etcd members command.
+var etcdMembersCmd = &cobra.Command{
+	Use:   "members",
+	Short: "Get the list of etcd cluster members",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.EtcdMemberList(ctx, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error listing etcd members: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tID\tHOSTNAME\tPEER URLS\tCLIENT URLS\tLEADER\tLEARNER")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				for _, member := range msg.Members {
+					fmt.Fprintf(w, "%s\t%x\t%s\t%s\t%s\t%v\t%v\n",
+						node,
+						member.Id,
+						member.Hostname,
+						strings.Join(member.PeerUrls, ","),
+						strings.Join(member.ClientUrls, ","),
+						member.IsLeader,
+						member.IsLearner,
+					)
+				}
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+// etcdRemoveMemberCmd represents the etcd remove-member command.
+var etcdRemoveMemberCmd = &cobra.Command{
+	Use:   "remove-member <hostname>",
+	Short: "Remove the member of another node from the etcd cluster",
+	Long: `Remove the member of the node which died permanently from the etcd cluster.
+The member is referenced by its hostname or hex ID, as listed by 'talosctl etcd members'.
+
+The removal is refused if the remaining healthy members are not the quorum, unless --force is set.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "etcd remove-member"); err != nil {
+				return err
+			}
+
+			if _, err := c.EtcdRemoveMember(ctx, &machineapi.EtcdRemoveMemberRequest{
+				Member: args[0],
+				Force:  etcdCmdFlags.force,
+			}); err != nil {
+				return fmt.Errorf("error removing etcd member: %w", err)
+			}
+
+			return nil
+		})
+	},
+}
+
+// etcdLeaveCmd represents the etcd leave command.
+var etcdLeaveCmd = &cobra.Command{
+	Use:   "leave",
+	Short: "Tell nodes to leave etcd cluster",
+	Long: `The node removes its member from the etcd cluster, etcd is stopped and its data is wiped.
+
+Leaving is refused if the remaining healthy members are not the quorum, unless --force is set.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if _, err := c.EtcdLeaveCluster(ctx, &machineapi.EtcdLeaveClusterRequest{
+				Force: etcdCmdFlags.force,
+			}); err != nil {
+				return fmt.Errorf("error leaving etcd cluster: %w", err)
+			}
+
+			return nil
+		})
+	},
+}
+
+// etcdForfeitLeadershipCmd represents the etcd forfeit-leadership command.
+var etcdForfeitLeadershipCmd = &cobra.Command{
+	Use:   "forfeit-leadership",
+	Short: "Tell node to forfeit etcd cluster leadership",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			resp, err := c.EtcdForfeitLeadership(ctx)
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error forfeiting etcd leadership: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			for _, msg := range resp.Messages {
+				if len(resp.Messages) > 1 && msg.Metadata != nil {
+					fmt.Printf("%s: ", msg.Metadata.Hostname)
+				}
+
+				fmt.Printf("etcd leader is %q\n", msg.Member)
+			}
+
+			return nil
+		})
+	},
+}
+
+// etcdStatusCmd represents the etcd status command.
+var etcdStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Get the status of etcd cluster member",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.EtcdStatus(ctx, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error getting etcd status: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tMEMBER\tDB SIZE\tIN USE\tQUOTA\tLEADER\tRAFT INDEX\tRAFT TERM\tRAFT APPLIED INDEX\tLEARNER\tERRORS")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				status := msg.MemberStatus
+
+				var ratio float64
+
+				if status.DbSize > 0 {
+					ratio = float64(status.DbSizeInUse) / float64(status.DbSize) * 100
+				}
+
+				fmt.Fprintf(w, "%s\t%x\t%s\t%s (%.2f%%)\t%s\t%x\t%d\t%d\t%d\t%v\t%s\n",
+					node,
+					status.MemberId,
+					humanize.Bytes(uint64(status.DbSize)),
+					humanize.Bytes(uint64(status.DbSizeInUse)),
+					ratio,
+					humanize.Bytes(uint64(status.QuotaBackendBytes)),
+					status.Leader,
+					status.RaftIndex,
+					status.RaftTerm,
+					status.RaftAppliedIndex,
+					status.IsLearner,
+					strings.Join(status.Errors, ", "),
+				)
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+// etcdAlarmCmd represents the etcd alarm command.
+var etcdAlarmCmd = &cobra.Command{
+	Use:   "alarm",
+	Short: "Manage etcd alarms",
+	Long:  ``,
+}
+
+// etcdAlarmListCmd represents the etcd alarm list command.
+var etcdAlarmListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the etcd alarms",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.EtcdAlarmList(ctx, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error listing etcd alarms: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tMEMBER\tALARM")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				printEtcdAlarms(w, defaultNode, msg.Metadata, msg.MemberAlarms)
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+// etcdAlarmDisarmCmd represents the etcd alarm disarm command.
+var etcdAlarmDisarmCmd = &cobra.Command{
+	Use:   "disarm",
+	Short: "Disarm the etcd alarms",
+	Long: `Disarm all the etcd alarms of the cluster.
+
+If the NOSPACE alarm was raised, etcd should be defragmented before the alarm is disarmed.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.EtcdAlarmDisarm(ctx, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error disarming etcd alarms: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tMEMBER\tALARM")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				printEtcdAlarms(w, defaultNode, msg.Metadata, msg.MemberAlarms)
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+func printEtcdAlarms(w io.Writer, defaultNode string, metadata *common.Metadata, alarms []*machineapi.EtcdMemberAlarm) {
+	node := defaultNode
+
+	if metadata != nil {
+		node = metadata.Hostname
+	}
+
+	for _, alarm := range alarms {
+		fmt.Fprintf(w, "%s\t%x\t%s\n", node, alarm.MemberId, alarm.Alarm)
+	}
+}
+
+// etcdDefragCmd represents the etcd defrag command.
+var etcdDefragCmd = &cobra.Command{
+	Use:   "defrag",
+	Short: "Defragment etcd storage of the nodes",
+	Long: `Defragment etcd storage of the nodes to reclaim the space freed by the compaction.
+
+The member doesn't serve the requests while it is defragmented, so the nodes are defragmented one at a time.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			for _, node := range Nodes {
+				fmt.Printf("defragmenting etcd on %s\n", node)
+
+				if _, err := c.EtcdDefragment(client.WithNodes(ctx, node)); err != nil {
+					return fmt.Errorf("error defragmenting etcd on %s: %w", node, err)
+				}
+			}
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	etcdRemoveMemberCmd.Flags().BoolVar(&etcdCmdFlags.force, "force", false, "remove the member even if the cluster loses the quorum")
+	etcdLeaveCmd.Flags().BoolVar(&etcdCmdFlags.force, "force", false, "leave the cluster even if it loses the quorum")
+
+	etcdAlarmCmd.AddCommand(etcdAlarmListCmd, etcdAlarmDisarmCmd)
+
+	etcdCmd.AddCommand(etcdMembersCmd, etcdRemoveMemberCmd, etcdLeaveCmd, etcdForfeitLeadershipCmd, etcdSnapshotCmd,
+		etcdStatusCmd, etcdAlarmCmd, etcdDefragCmd)
+	addCommand(etcdCmd)
+}
@@ -75,12 +75,22 @@ var eventsCmd = &cobra.Command{
 						} else {
 							args = append(args, msg.GetAction().String())
 						}
+					case *machine.SequenceInterruptedEvent:
+						checkpoint := msg.GetCheckpoint()
+						args = []interface{}{checkpoint.GetSequence(), fmt.Sprintf("interrupted in phase %s (%d/%d)", checkpoint.GetPhaseName(), checkpoint.GetPhase(), checkpoint.GetTotalPhases())}
 					case *machine.PhaseEvent:
 						args = []interface{}{msg.GetPhase(), msg.GetAction().String()}
 					case *machine.TaskEvent:
 						args = []interface{}{msg.GetTask(), msg.GetAction().String()}
 					case *machine.ServiceStateEvent:
 						args = []interface{}{msg.GetService(), fmt.Sprintf("%s: %s", msg.GetAction(), msg.GetMessage())}
+					case *machine.ManifestSyncEvent:
+						action := fmt.Sprintf("%s %s", msg.GetAction(), msg.GetObject())
+						if msg.GetError() != "" {
+							action += ": " + msg.GetError()
+						}
+
+						args = []interface{}{msg.GetManifest(), action}
 					default:
 						// We haven't implemented the handling of this event yet.
 						continue
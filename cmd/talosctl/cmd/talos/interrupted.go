@@ -0,0 +1,85 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/talos-systems/talos/pkg/cli"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+// interruptedCmd represents the interrupted command.
+var interruptedCmd = &cobra.Command{
+	Use:   "interrupted [resume|rollback|discard]",
+	Short: "Show or resolve a sequence interrupted before the last boot",
+	Long: `If run without arguments, shows the sequence (upgrade, reset) which was interrupted before the last boot, e.g. by a power loss.
+With action 'resume', the interrupted sequence is run again with the same parameters.
+With action 'rollback', the node is reverted to the installation which was running before the interrupted upgrade.
+With action 'discard', the interrupted sequence is forgotten.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		action := machineapi.InterruptedSequenceRequest_STATUS
+
+		if len(args) == 1 {
+			value, ok := machineapi.InterruptedSequenceRequest_Action_value[strings.ToUpper(args[0])]
+			if !ok || value == int32(machineapi.InterruptedSequenceRequest_STATUS) {
+				return fmt.Errorf("unsupported interrupted sequence action: %q", args[0])
+			}
+
+			action = machineapi.InterruptedSequenceRequest_Action(value)
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.InterruptedSequence(ctx, action, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error processing interrupted sequence: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tSEQUENCE\tPHASE")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				checkpoint := msg.GetCheckpoint()
+				if checkpoint == nil {
+					fmt.Fprintf(w, "%s\t%s\t%s\n", node, "<none>", "")
+
+					continue
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%s (%d/%d)\n", node, checkpoint.Sequence, checkpoint.PhaseName, checkpoint.Phase, checkpoint.TotalPhases)
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+func init() {
+	addCommand(interruptedCmd)
+}
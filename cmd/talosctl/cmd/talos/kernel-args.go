@@ -0,0 +1,117 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/talos-systems/talos/pkg/cli"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+// kernelArgsCmd represents the kernel-args command.
+var kernelArgsCmd = &cobra.Command{
+	Use:   "kernel-args",
+	Short: "Manage the kernel args of the installation",
+	Long: `The kernel args of the boot label booted by default are updated, the changes take effect on the next boot.
+The changes are recorded in META and applied on top of install.extraKernelArgs from the machine config on every upgrade.`,
+}
+
+// kernelArgsGetCmd represents the kernel-args get command.
+var kernelArgsGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Print the kernel args",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.KernelArgs(ctx, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error getting kernel args: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			return kernelArgsRender(&remotePeer, resp)
+		})
+	},
+}
+
+// kernelArgsSetCmd represents the kernel-args set command.
+var kernelArgsSetCmd = &cobra.Command{
+	Use:   "set <key=value>...",
+	Short: "Set the kernel args",
+	Long:  `All the values of the key are replaced with the specified ones.`,
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return kernelArgsUpdate(args, nil)
+	},
+}
+
+// kernelArgsRemoveCmd represents the kernel-args remove command.
+var kernelArgsRemoveCmd = &cobra.Command{
+	Use:     "remove <key>...",
+	Aliases: []string{"rm"},
+	Short:   "Remove the kernel args",
+	Long:    ``,
+	Args:    cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return kernelArgsUpdate(nil, args)
+	},
+}
+
+func kernelArgsUpdate(set, remove []string) error {
+	return WithClient(func(ctx context.Context, c *client.Client) error {
+		var remotePeer peer.Peer
+
+		resp, err := c.KernelArgsUpdate(ctx, set, remove, grpc.Peer(&remotePeer))
+		if err != nil {
+			if resp == nil {
+				return fmt.Errorf("error updating kernel args: %w", err)
+			}
+
+			cli.Warning("%s", err)
+		}
+
+		return kernelArgsRender(&remotePeer, resp)
+	})
+}
+
+func kernelArgsRender(remotePeer *peer.Peer, resp *machineapi.KernelArgsResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NODE\tLABEL\tARGS")
+
+	defaultNode := client.AddrFromPeer(remotePeer)
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\n", node, msg.Label, strings.Join(msg.Args, " "))
+	}
+
+	return w.Flush()
+}
+
+func init() {
+	kernelArgsCmd.AddCommand(kernelArgsGetCmd, kernelArgsSetCmd, kernelArgsRemoveCmd)
+	addCommand(kernelArgsCmd)
+}
@@ -13,14 +13,17 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"k8s.io/client-go/tools/clientcmd"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
 
 	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
 	"github.com/talos-systems/talos/internal/pkg/kubeconfig"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
 )
 
@@ -28,6 +31,12 @@ var (
 	force            bool
 	forceContextName string
 	merge            bool
+
+	kubeconfigCmdFlags struct {
+		username string
+		groups   []string
+		ttl      time.Duration
+	}
 )
 
 // kubeconfigCmd represents the kubeconfig command.
@@ -36,7 +45,11 @@ var kubeconfigCmd = &cobra.Command{
 	Short: "Download the admin kubeconfig from the node",
 	Long: `Download the admin kubeconfig from the node.
 If merge flag is defined, config will be merged with ~/.kube/config or [local-path] if specified.
-Otherwise kubeconfig will be written to PWD or [local-path] if specified.`,
+Otherwise kubeconfig will be written to PWD or [local-path] if specified.
+
+If username flag is defined, the kubeconfig is issued for the user and the groups
+instead of the admin, the user gets no permissions unless the groups or the user
+are bound to the roles, e.g. to the view ClusterRole.`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return WithClient(func(ctx context.Context, c *client.Client) error {
@@ -94,7 +107,16 @@ Otherwise kubeconfig will be written to PWD or [local-path] if specified.`,
 				}
 			}
 
-			r, errCh, err := c.KubeconfigRaw(ctx)
+			req := &machineapi.KubeconfigRequest{
+				Username: kubeconfigCmdFlags.username,
+				Groups:   kubeconfigCmdFlags.groups,
+			}
+
+			if kubeconfigCmdFlags.ttl > 0 {
+				req.Ttl = ptypes.DurationProto(kubeconfigCmdFlags.ttl)
+			}
+
+			r, errCh, err := c.ScopedKubeconfigRaw(ctx, req)
 			if err != nil {
 				return fmt.Errorf("error copying: %w", err)
 			}
@@ -187,5 +209,8 @@ func init() {
 	kubeconfigCmd.Flags().BoolVarP(&force, "force", "f", false, "Force overwrite of kubeconfig if already present, force overwrite on kubeconfig merge")
 	kubeconfigCmd.Flags().StringVar(&forceContextName, "force-context-name", "", "Force context name for kubeconfig merge")
 	kubeconfigCmd.Flags().BoolVarP(&merge, "merge", "m", true, "Merge with existing kubeconfig")
+	kubeconfigCmd.Flags().StringVar(&kubeconfigCmdFlags.username, "username", "", "issue the kubeconfig for the username instead of the admin")
+	kubeconfigCmd.Flags().StringSliceVar(&kubeconfigCmdFlags.groups, "groups", nil, "groups of the user, requires --username")
+	kubeconfigCmd.Flags().DurationVar(&kubeconfigCmdFlags.ttl, "ttl", 0, "lifetime of the client certificate, defaults to and can't exceed the admin kubeconfig lifetime from the machine config")
 	addCommand(kubeconfigCmd)
 }
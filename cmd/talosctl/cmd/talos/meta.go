@@ -0,0 +1,139 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/talos-systems/talos/pkg/cli"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+// metaCmd represents the meta command.
+var metaCmd = &cobra.Command{
+	Use:   "meta",
+	Short: "Manage the key/value store on the META partition",
+	Long: `The key/value store on the META partition keeps the per-node data,
+which survives the reinstall of the STATE partition.
+The keys prefixed with "talos/" are reserved for Talos, and they can't be changed.`,
+}
+
+// metaListCmd represents the meta list command.
+var metaListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the keys and the values",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.MetaList(ctx, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error listing META keys: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tKEY\tVALUE")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				for _, entry := range msg.Entries {
+					fmt.Fprintf(w, "%s\t%s\t%q\n", node, entry.Key, entry.Value)
+				}
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+// metaGetCmd represents the meta get command.
+var metaGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Print the value of the key",
+	Long:  ``,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			resp, err := c.MetaGet(ctx, args[0])
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error getting META key: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			for _, msg := range resp.Messages {
+				if len(resp.Messages) > 1 && msg.Metadata != nil {
+					fmt.Printf("%s: ", msg.Metadata.Hostname)
+				}
+
+				fmt.Println(msg.GetEntry().GetValue())
+			}
+
+			return nil
+		})
+	},
+}
+
+// metaSetCmd represents the meta set command.
+var metaSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Set the value of the key",
+	Long:  ``,
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if _, err := c.MetaSet(ctx, args[0], args[1]); err != nil {
+				return fmt.Errorf("error setting META key: %w", err)
+			}
+
+			return nil
+		})
+	},
+}
+
+// metaDeleteCmd represents the meta delete command.
+var metaDeleteCmd = &cobra.Command{
+	Use:     "delete <key>",
+	Aliases: []string{"rm"},
+	Short:   "Delete the key",
+	Long:    ``,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if _, err := c.MetaDelete(ctx, args[0]); err != nil {
+				return fmt.Errorf("error deleting META key: %w", err)
+			}
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	metaCmd.AddCommand(metaListCmd, metaGetCmd, metaSetCmd, metaDeleteCmd)
+	addCommand(metaCmd)
+}
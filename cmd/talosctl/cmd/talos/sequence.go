@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/talos-systems/talos/pkg/cli"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+// sequenceCmd represents the sequence command.
+var sequenceCmd = &cobra.Command{
+	Use:   "sequence",
+	Short: "List running and queued sequences",
+	Long:  `Shows the sequences running on the node with their progress, and the sequences queued to run next.`,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.Sequences(ctx, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error listing sequences: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tSEQUENCE\tPHASE\tTASKS\tSTARTED")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				for _, seq := range msg.Running {
+					name := seq.Sequence
+					if seq.Canceled {
+						name += " (canceling)"
+					}
+
+					// nolint: errcheck
+					started, _ := ptypes.Timestamp(seq.Started)
+
+					fmt.Fprintf(w, "%s\t%s\t%s (%d/%d)\t%s\t%s ago\n", node, name, seq.PhaseName, seq.Phase, seq.TotalPhases,
+						strings.Join(seq.Tasks, ","), time.Since(started).Round(time.Second))
+				}
+
+				for _, seq := range msg.Queued {
+					fmt.Fprintf(w, "%s\t%s (queued)\t\t\t\n", node, seq)
+				}
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+// sequenceCancelCmd represents the sequence cancel command.
+var sequenceCancelCmd = &cobra.Command{
+	Use:   "cancel <sequence>",
+	Short: "Cancel the running sequence",
+	Long: `The tasks of the current phase (e.g. the node drain) are canceled via their context, and the sequence is stopped before its next phase.
+Sequences which leave the node unusable if stopped (initialize, install, boot) can't be canceled,
+other sequences can't be canceled once a destructive phase has started: leaving etcd, resetting
+or upgrading the disk, stopping the services or unmounting the filesystems, rebooting or shutting down.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if _, err := c.SequenceCancel(ctx, args[0]); err != nil {
+				return fmt.Errorf("error canceling sequence: %w", err)
+			}
+
+			return nil
+		})
+	},
+}
+
+// sequenceQueueCmd represents the sequence queue command.
+var sequenceQueueCmd = &cobra.Command{
+	Use:   "queue <sequence>",
+	Short: "Queue a sequence to run after the running one",
+	Long:  `The sequence is started as soon as no other sequence is running. Only reboot and shutdown can be queued.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if _, err := c.SequenceQueue(ctx, args[0]); err != nil {
+				return fmt.Errorf("error queueing sequence: %w", err)
+			}
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	sequenceCmd.AddCommand(sequenceCancelCmd)
+	sequenceCmd.AddCommand(sequenceQueueCmd)
+	addCommand(sequenceCmd)
+}
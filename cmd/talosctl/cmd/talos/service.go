@@ -11,6 +11,7 @@ import (
 	"text/tabwriter"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
@@ -21,6 +22,11 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/client"
 )
 
+var serviceCmdFlags struct {
+	graph       bool
+	graphFormat string
+}
+
 // serviceCmd represents the service command.
 var serviceCmd = &cobra.Command{
 	Use:     "service [<id> [start|stop|restart|status]]",
@@ -28,7 +34,8 @@ var serviceCmd = &cobra.Command{
 	Short:   "Retrieve the state of a service (or all services), control service state",
 	Long: `Service control command. If run without arguments, lists all the services and their state.
 If service ID is specified, default action 'status' is executed which shows status of a single list service.
-With actions 'start', 'stop', 'restart', service state is updated respectively.`,
+With actions 'start', 'stop', 'restart', service state is updated respectively.
+With '--graph' flag, service dependency graph is printed instead of the list of services.`,
 	Args: cobra.MaximumNArgs(2),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		action := "status"
@@ -46,6 +53,10 @@ With actions 'start', 'stop', 'restart', service state is updated respectively.`
 			switch action {
 			case "status":
 				if serviceID == "" {
+					if serviceCmdFlags.graph {
+						return serviceGraph(ctx, c)
+					}
+
 					return serviceList(ctx, c)
 				}
 
@@ -76,7 +87,7 @@ func serviceList(ctx context.Context, c *client.Client) error {
 	}
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
-	fmt.Fprintln(w, "NODE\tSERVICE\tSTATE\tHEALTH\tLAST CHANGE\tLAST EVENT")
+	fmt.Fprintln(w, "NODE\tSERVICE\tSTATE\tHEALTH\tUPTIME\tRESTARTS\tLAST CHANGE\tLAST EVENT")
 
 	defaultNode := client.AddrFromPeer(&remotePeer)
 
@@ -90,13 +101,129 @@ func serviceList(ctx context.Context, c *client.Client) error {
 				node = msg.Metadata.Hostname
 			}
 
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s ago\t%s\n", node, svc.Id, svc.State, svc.HealthStatus(), svc.LastUpdated(), svc.LastEvent())
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%s ago\t%s\n", node, svc.Id, svc.State, svc.HealthStatus(), svc.Uptime(), svc.GetStats().GetRestarts(), svc.LastUpdated(), svc.LastEvent())
 		}
 	}
 
 	return w.Flush()
 }
 
+func serviceGraph(ctx context.Context, c *client.Client) error {
+	var remotePeer peer.Peer
+
+	resp, err := c.ServiceGraph(ctx, grpc.Peer(&remotePeer))
+	if err != nil {
+		if resp == nil {
+			return fmt.Errorf("error getting service graph: %w", err)
+		}
+
+		cli.Warning("%s", err)
+	}
+
+	defaultNode := client.AddrFromPeer(&remotePeer)
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		switch serviceCmdFlags.graphFormat {
+		case "tree":
+			printServiceTree(node, msg.Nodes)
+		case "dot":
+			printServiceDOT(node, msg.Nodes)
+		default:
+			return fmt.Errorf("unsupported graph format: %q", serviceCmdFlags.graphFormat)
+		}
+	}
+
+	return nil
+}
+
+// printServiceTree prints services as a tree with each service nested under the services it depends on.
+//
+// Service which depends on several other services is printed under each of them.
+func printServiceTree(node string, nodes []*machineapi.ServiceGraphNode) {
+	index := make(map[string]*machineapi.ServiceGraphNode, len(nodes))
+
+	for _, n := range nodes {
+		index[n.Id] = n
+	}
+
+	dependents := make(map[string][]string)
+	roots := []string{}
+
+	for _, n := range nodes {
+		isRoot := true
+
+		for _, dep := range n.DependsOn {
+			if _, exists := index[dep]; exists {
+				dependents[dep] = append(dependents[dep], n.Id)
+				isRoot = false
+			}
+		}
+
+		if isRoot {
+			roots = append(roots, n.Id)
+		}
+	}
+
+	var printNode func(id, prefix, childPrefix string)
+
+	printNode = func(id, prefix, childPrefix string) {
+		n := index[id]
+
+		line := fmt.Sprintf("%s%s [%s]", prefix, n.Id, n.State)
+
+		if n.Condition != "" {
+			line += fmt.Sprintf(" (waits for %s)", n.Condition)
+		}
+
+		fmt.Println(line)
+
+		children := dependents[id]
+
+		for i, child := range children {
+			if i == len(children)-1 {
+				printNode(child, childPrefix+"└── ", childPrefix+"    ")
+			} else {
+				printNode(child, childPrefix+"├── ", childPrefix+"│   ")
+			}
+		}
+	}
+
+	fmt.Printf("NODE %s\n", node)
+
+	for _, id := range roots {
+		printNode(id, "", "")
+	}
+}
+
+// printServiceDOT prints services graph in Graphviz DOT format, edges point from service to its dependency.
+func printServiceDOT(node string, nodes []*machineapi.ServiceGraphNode) {
+	fmt.Printf("digraph %q {\n", node)
+
+	for _, n := range nodes {
+		label := fmt.Sprintf("%s\n%s", n.Id, n.State)
+
+		if n.Condition != "" {
+			label += fmt.Sprintf("\nwaits for %s", n.Condition)
+		}
+
+		fmt.Printf("\t%q [label=%q];\n", n.Id, label)
+	}
+
+	for _, n := range nodes {
+		for _, dep := range n.DependsOn {
+			fmt.Printf("\t%q -> %q;\n", n.Id, dep)
+		}
+	}
+
+	fmt.Println("}")
+}
+
 func serviceInfo(ctx context.Context, c *client.Client, id string) error {
 	var remotePeer peer.Peer
 
@@ -131,6 +258,18 @@ func serviceInfo(ctx context.Context, c *client.Client, id string) error {
 			fmt.Fprintf(w, "LAST HEALTH MESSAGE\t%s\n", svc.Health.LastMessage)
 		}
 
+		if stats := svc.GetStats(); stats != nil {
+			if stats.Pid != 0 {
+				fmt.Fprintf(w, "PID\t%d\n", stats.Pid)
+				fmt.Fprintf(w, "UPTIME\t%s\n", svc.Uptime())
+				fmt.Fprintf(w, "CPU TIME\t%s\n", time.Duration(stats.CpuUsage).Round(time.Millisecond))
+				fmt.Fprintf(w, "MEMORY\t%s\n", humanize.Bytes(stats.MemoryUsage))
+			}
+
+			fmt.Fprintf(w, "RESTARTS\t%d\n", stats.Restarts)
+			fmt.Fprintf(w, "LAST EXIT\t%s\n", svc.LastExit())
+		}
+
 		label := "EVENTS"
 
 		for i := range svc.Events.Events {
@@ -263,6 +402,25 @@ func (svc serviceInfoWrapper) LastEvent() string {
 	return svc.Events.Events[len(svc.Events.Events)-1].Msg
 }
 
+func (svc serviceInfoWrapper) Uptime() string {
+	if svc.State != "Running" || svc.GetStats().GetStarted() == nil {
+		return "-"
+	}
+
+	// nolint: errcheck
+	ts, _ := ptypes.Timestamp(svc.Stats.Started)
+
+	return time.Since(ts).Round(time.Second).String()
+}
+
+func (svc serviceInfoWrapper) LastExit() string {
+	if svc.Stats.LastExitSignal != "" {
+		return fmt.Sprintf("signal: %s", svc.Stats.LastExitSignal)
+	}
+
+	return fmt.Sprintf("exit status %d", svc.Stats.LastExitCode)
+}
+
 func (svc serviceInfoWrapper) HealthStatus() string {
 	if svc.Health.Unknown {
 		return "?"
@@ -276,5 +434,7 @@ func (svc serviceInfoWrapper) HealthStatus() string {
 }
 
 func init() {
+	serviceCmd.Flags().BoolVar(&serviceCmdFlags.graph, "graph", false, "print service dependency graph")
+	serviceCmd.Flags().StringVar(&serviceCmdFlags.graphFormat, "graph-format", "tree", "service dependency graph format (tree or dot)")
 	addCommand(serviceCmd)
 }
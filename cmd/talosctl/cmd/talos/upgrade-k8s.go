@@ -7,6 +7,7 @@ package talos
 import (
 	"context"
 	"runtime"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -21,23 +22,35 @@ import (
 var upgradeK8sCmd = &cobra.Command{
 	Use:   "upgrade-k8s",
 	Short: "Upgrade Kubernetes control plane in the Talos cluster.",
-	Long:  `Command runs upgrade of Kubernetes control plane components between specified versions. Pod-checkpointer is handled in a special way to speed up kube-apisever upgrades.`,
-	Args:  cobra.NoArgs,
+	Long: `Command runs upgrade of Kubernetes control plane components between specified versions. Pod-checkpointer is handled in a special way to speed up kube-apisever upgrades.
+
+The components are updated one at a time, each component has to become ready before the next one is updated.
+The component which doesn't become ready is rolled back unless --rollback=false is set.
+Use --dry-run to print the upgrade plan without applying it.`,
+	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return WithClient(upgradeKubernetes)
 	},
 }
 
 var upgradeK8sCmdFlags struct {
-	fromVersion string
-	toVersion   string
-	arch        string
+	fromVersion      string
+	toVersion        string
+	arch             string
+	dryRun           bool
+	prePullImages    bool
+	rollback         bool
+	readinessTimeout time.Duration
 }
 
 func init() {
 	upgradeK8sCmd.Flags().StringVar(&upgradeK8sCmdFlags.fromVersion, "from", "", "the Kubernetes control plane version to upgrade from")
 	upgradeK8sCmd.Flags().StringVar(&upgradeK8sCmdFlags.toVersion, "to", constants.DefaultKubernetesVersion, "the Kubernetes control plane version to upgrade to")
 	upgradeK8sCmd.Flags().StringVar(&upgradeK8sCmdFlags.arch, "arch", runtime.GOARCH, "the cluster architecture")
+	upgradeK8sCmd.Flags().BoolVar(&upgradeK8sCmdFlags.dryRun, "dry-run", false, "print the upgrade plan without applying it")
+	upgradeK8sCmd.Flags().BoolVar(&upgradeK8sCmdFlags.prePullImages, "pre-pull-images", k8s.DefaultUpgradeOptions().PrePullImages, "pull the images of the target version on the nodes before the upgrade")
+	upgradeK8sCmd.Flags().BoolVar(&upgradeK8sCmdFlags.rollback, "rollback", k8s.DefaultUpgradeOptions().Rollback, "roll back the component which doesn't become ready")
+	upgradeK8sCmd.Flags().DurationVar(&upgradeK8sCmdFlags.readinessTimeout, "readiness-timeout", k8s.DefaultUpgradeOptions().ReadinessTimeout, "timeout to wait for each component to become ready")
 	cli.Should(upgradeK8sCmd.MarkFlagRequired("from"))
 	cli.Should(upgradeK8sCmd.MarkFlagRequired("to"))
 	addCommand(upgradeK8sCmd)
@@ -50,13 +63,25 @@ func upgradeKubernetes(ctx context.Context, c *client.Client) error {
 	defer clientProvider.Close() //nolint: errcheck
 
 	state := struct {
+		cluster.ClientProvider
 		cluster.K8sProvider
 	}{
+		ClientProvider: clientProvider,
 		K8sProvider: &cluster.KubernetesClient{
 			ClientProvider: clientProvider,
 			ForceEndpoint:  healthCmdFlags.forceEndpoint,
 		},
 	}
 
-	return k8s.Upgrade(ctx, &state, upgradeK8sCmdFlags.arch, upgradeK8sCmdFlags.fromVersion, upgradeK8sCmdFlags.toVersion)
+	options := k8s.DefaultUpgradeOptions()
+
+	options.FromVersion = upgradeK8sCmdFlags.fromVersion
+	options.ToVersion = upgradeK8sCmdFlags.toVersion
+	options.Architecture = upgradeK8sCmdFlags.arch
+	options.DryRun = upgradeK8sCmdFlags.dryRun
+	options.PrePullImages = upgradeK8sCmdFlags.prePullImages
+	options.Rollback = upgradeK8sCmdFlags.rollback
+	options.ReadinessTimeout = upgradeK8sCmdFlags.readinessTimeout
+
+	return k8s.Upgrade(ctx, &state, options)
 }
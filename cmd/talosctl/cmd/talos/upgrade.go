@@ -11,17 +11,23 @@ import (
 	"text/tabwriter"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
 
 	"github.com/talos-systems/talos/pkg/cli"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
 )
 
 var (
-	upgradeImage string
-	preserve     bool
+	upgradeImage   string
+	preserve       bool
+	upgradeStage   bool
+	upgradeCommit  bool
+	upgradeDiscard bool
+	upgradeArchive string
 )
 
 // upgradeCmd represents the processes command.
@@ -31,14 +37,42 @@ var upgradeCmd = &cobra.Command{
 	Long:  ``,
 	Args:  cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if (upgradeStage && upgradeCommit) || (upgradeStage && upgradeDiscard) || (upgradeCommit && upgradeDiscard) {
+			return fmt.Errorf("--stage, --commit and --discard are mutually exclusive")
+		}
+
+		if upgradeArchive != "" && (upgradeCommit || upgradeDiscard) {
+			return fmt.Errorf("--archive is mutually exclusive with --commit and --discard")
+		}
+
+		if upgradeArchive != "" && upgradeImage == "" {
+			return fmt.Errorf("--image is required to import the archive as")
+		}
+
 		return upgrade()
 	},
 }
 
+// upgradeStatusCmd represents the upgrade-status command.
+var upgradeStatusCmd = &cobra.Command{
+	Use:   "upgrade-status",
+	Short: "Show the status of the staged upgrade on the target node",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return upgradeStatus()
+	},
+}
+
 func init() {
 	upgradeCmd.Flags().StringVarP(&upgradeImage, "image", "i", "", "the container image to use for performing the install")
 	upgradeCmd.Flags().BoolVarP(&preserve, "preserve", "p", false, "preserve data")
+	upgradeCmd.Flags().BoolVar(&upgradeStage, "stage", false, "install the image while the node keeps running, the upgrade is activated by --commit or by the next reboot")
+	upgradeCmd.Flags().BoolVar(&upgradeCommit, "commit", false, "reboot into the staged upgrade")
+	upgradeCmd.Flags().BoolVar(&upgradeDiscard, "discard", false, "discard the staged upgrade, the node keeps booting the running version")
+	upgradeCmd.Flags().StringVar(&upgradeArchive, "archive", "", "the installer image archive (OCI or docker tarball) to upload to the node, the image is imported as --image")
 	addCommand(upgradeCmd)
+	addCommand(upgradeStatusCmd)
 }
 
 func upgrade() error {
@@ -47,7 +81,28 @@ func upgrade() error {
 
 		// TODO: See if we can validate version and prevent starting upgrades to
 		// an unknown version
-		resp, err := c.Upgrade(ctx, upgradeImage, preserve, grpc.Peer(&remotePeer))
+		var (
+			resp *machineapi.UpgradeResponse
+			err  error
+		)
+
+		switch {
+		case upgradeArchive != "":
+			if err = importImageArchive(ctx, c); err != nil {
+				return err
+			}
+
+			resp, err = c.UpgradeFromImport(ctx, upgradeImage, preserve, upgradeStage, grpc.Peer(&remotePeer))
+		case upgradeStage:
+			resp, err = c.StageUpgrade(ctx, upgradeImage, grpc.Peer(&remotePeer))
+		case upgradeCommit:
+			resp, err = c.CommitUpgrade(ctx, grpc.Peer(&remotePeer))
+		case upgradeDiscard:
+			resp, err = c.DiscardUpgrade(ctx, grpc.Peer(&remotePeer))
+		default:
+			resp, err = c.Upgrade(ctx, upgradeImage, preserve, grpc.Peer(&remotePeer))
+		}
+
 		if err != nil {
 			if resp == nil {
 				return fmt.Errorf("error performing upgrade: %s", err)
@@ -74,3 +129,67 @@ func upgrade() error {
 		return w.Flush()
 	})
 }
+
+// importImageArchive uploads the installer image archive to the nodes.
+func importImageArchive(ctx context.Context, c *client.Client) error {
+	f, err := os.Open(upgradeArchive)
+	if err != nil {
+		return err
+	}
+
+	// nolint: errcheck
+	defer f.Close()
+
+	resp, err := c.ImageImport(ctx, upgradeImage, f)
+	if err != nil {
+		return fmt.Errorf("error importing image archive: %s", err)
+	}
+
+	for _, msg := range resp {
+		if msg.Metadata != nil && msg.Metadata.Error != "" {
+			return fmt.Errorf("error importing image archive on %s: %s", msg.Metadata.Hostname, msg.Metadata.Error)
+		}
+	}
+
+	return nil
+}
+
+func upgradeStatus() error {
+	return WithClient(func(ctx context.Context, c *client.Client) error {
+		var remotePeer peer.Peer
+
+		resp, err := c.UpgradeStatus(ctx, grpc.Peer(&remotePeer))
+		if err != nil {
+			if resp == nil {
+				return fmt.Errorf("error getting upgrade status: %s", err)
+			}
+
+			cli.Warning("%s", err)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "NODE\tSTAGE\tIMAGE\tLABEL\tUPDATED\tERROR")
+
+		defaultNode := client.AddrFromPeer(&remotePeer)
+
+		for _, msg := range resp.Messages {
+			node := defaultNode
+
+			if msg.Metadata != nil {
+				node = msg.Metadata.Hostname
+			}
+
+			updated := ""
+
+			if msg.Updated != nil {
+				if ts, err := ptypes.Timestamp(msg.Updated); err == nil {
+					updated = ts.Format(time.RFC3339)
+				}
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", node, msg.Stage, msg.Image, msg.Label, updated, msg.Error)
+		}
+
+		return w.Flush()
+	})
+}
@@ -71,6 +71,7 @@ require (
 	github.com/vmware-tanzu/sonobuoy v0.19.0
 	github.com/vmware/vmw-guestinfo v0.0.0-20200218095840-687661b8bd8e
 	go.etcd.io/etcd v0.5.0-alpha.5.0.20200819165624-17cef6e3e9d5 // v3.4.10
+	go.uber.org/zap v1.14.1
 	golang.org/x/crypto v0.0.0-20200709230013-948cd5f35899
 	golang.org/x/net v0.0.0-20200707034311-ab3426394381
 	golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d // indirect
@@ -89,4 +90,5 @@ require (
 	k8s.io/client-go v0.19.1
 	k8s.io/cri-api v0.19.1
 	k8s.io/kubelet v0.19.1
+	sigs.k8s.io/yaml v1.2.0
 )
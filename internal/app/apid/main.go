@@ -69,6 +69,8 @@ func main() {
 	for _, methodName := range []string{
 		"/machine.MachineService/Copy",
 		"/machine.MachineService/Dmesg",
+		"/machine.MachineService/EtcdRecover",
+		"/machine.MachineService/EtcdSnapshot",
 		"/machine.MachineService/Events",
 		"/machine.MachineService/Kubeconfig",
 		"/machine.MachineService/List",
@@ -0,0 +1,213 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/talos-systems/bootkube-plugin/pkg/asset"
+	"gopkg.in/yaml.v3"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+const (
+	// apiServerSecretsDir is the directory the apiserver secret is mounted to.
+	apiServerSecretsDir = "/etc/kubernetes/secrets"
+
+	// assetPathAdmissionConfig is stored along with the audit policy, so that
+	// it's added to the apiserver secret and to the bootstrap secrets.
+	assetPathAdmissionConfig = "tls/admissionconfig.yaml"
+
+	auditLogVolume = "audit-log"
+	auditLogFile   = "kube-apiserver.log"
+)
+
+type admissionConfiguration struct {
+	APIVersion string                         `yaml:"apiVersion"`
+	Kind       string                         `yaml:"kind"`
+	Plugins    []admissionPluginConfiguration `yaml:"plugins"`
+}
+
+type admissionPluginConfiguration struct {
+	Name          string                 `yaml:"name"`
+	Configuration map[string]interface{} `yaml:"configuration"`
+}
+
+// apiServerExtraArgs merges the apiserver extra args with the args generated
+// from the audit log and the admission control config.
+func apiServerExtraArgs(cfg config.APIServer) map[string]string {
+	args := make(map[string]string, len(cfg.ExtraArgs()))
+
+	for k, v := range cfg.ExtraArgs() {
+		args[k] = v
+	}
+
+	if plugins := cfg.AdmissionControl(); len(plugins) > 0 {
+		names := make([]string, 0, len(plugins)+1)
+
+		// the flag is repeated after the default plugins, so the plugins are
+		// enabled on top of the defaults
+		if enabled := args["enable-admission-plugins"]; enabled != "" {
+			names = append(names, enabled)
+		}
+
+		for _, plugin := range plugins {
+			names = append(names, plugin.Name())
+		}
+
+		args["enable-admission-plugins"] = strings.Join(names, ",")
+		args["admission-control-config-file"] = path.Join(apiServerSecretsDir, filepath.Base(assetPathAdmissionConfig))
+	}
+
+	if auditLog := cfg.AuditLog(); auditLog.Destination() == constants.AuditLogDestinationFile {
+		args["audit-log-path"] = path.Join(constants.KubernetesAuditLogDir, auditLogFile)
+		args["audit-log-maxage"] = strconv.Itoa(auditLog.MaxAge())
+		args["audit-log-maxbackup"] = strconv.Itoa(auditLog.MaxBackup())
+		args["audit-log-maxsize"] = strconv.Itoa(auditLog.MaxSize())
+	}
+
+	return args
+}
+
+// customizeAPIServerAssets writes the audit policy and the admission config
+// to the apiserver secrets, and mounts the audit log directory if the audit
+// log is written to the file.
+//
+// nolint: gocyclo
+func customizeAPIServerAssets(assets asset.Assets, cfg config.APIServer) (asset.Assets, error) {
+	secrets := map[string][]byte{}
+
+	if policy := cfg.AuditPolicy(); policy != nil {
+		data, err := yaml.Marshal(policy)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal audit policy: %w", err)
+		}
+
+		for i := range assets {
+			if assets[i].Name == asset.AssetPathAuditPolicy {
+				assets[i].Data = data
+			}
+		}
+
+		secrets[filepath.Base(asset.AssetPathAuditPolicy)] = data
+	}
+
+	if plugins := cfg.AdmissionControl(); len(plugins) > 0 {
+		admissionConfig := admissionConfiguration{
+			APIVersion: "apiserver.config.k8s.io/v1",
+			Kind:       "AdmissionConfiguration",
+		}
+
+		for _, plugin := range plugins {
+			if plugin.Configuration() == nil {
+				continue
+			}
+
+			admissionConfig.Plugins = append(admissionConfig.Plugins, admissionPluginConfiguration{
+				Name:          plugin.Name(),
+				Configuration: plugin.Configuration(),
+			})
+		}
+
+		data, err := yaml.Marshal(admissionConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal admission configuration: %w", err)
+		}
+
+		assets = append(assets, asset.Asset{Name: assetPathAdmissionConfig, Data: data})
+
+		secrets[filepath.Base(assetPathAdmissionConfig)] = data
+	}
+
+	if len(secrets) > 0 {
+		var secret corev1.Secret
+
+		if err := patchManifest(assets, asset.AssetPathAPIServerSecret, &secret, func() {
+			for name, data := range secrets {
+				secret.Data[name] = data
+			}
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.AuditLog().Destination() == constants.AuditLogDestinationFile {
+		var daemonset appsv1.DaemonSet
+
+		if err := patchManifest(assets, asset.AssetPathAPIServer, &daemonset, func() {
+			mountAuditLog(&daemonset.Spec.Template.Spec)
+		}); err != nil {
+			return nil, err
+		}
+
+		var pod corev1.Pod
+
+		if err := patchManifest(assets, asset.AssetPathBootstrapAPIServer, &pod, func() {
+			mountAuditLog(&pod.Spec)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return assets, nil
+}
+
+// mountAuditLog mounts the audit log directory into the apiserver container.
+//
+// The directory is created by machined and owned by the user the apiserver
+// runs as.
+func mountAuditLog(spec *corev1.PodSpec) {
+	spec.Volumes = append(spec.Volumes, corev1.Volume{
+		Name: auditLogVolume,
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{
+				Path: constants.KubernetesAuditLogDir,
+			},
+		},
+	})
+
+	for i := range spec.Containers {
+		spec.Containers[i].VolumeMounts = append(spec.Containers[i].VolumeMounts, corev1.VolumeMount{
+			Name:      auditLogVolume,
+			MountPath: constants.KubernetesAuditLogDir,
+		})
+	}
+}
+
+// patchManifest decodes the manifest asset into the object, patches and
+// encodes it back.
+func patchManifest(assets asset.Assets, name string, obj interface{}, patch func()) error {
+	for i := range assets {
+		if assets[i].Name != name {
+			continue
+		}
+
+		if err := k8syaml.Unmarshal(assets[i].Data, obj); err != nil {
+			return fmt.Errorf("failed to decode %q: %w", name, err)
+		}
+
+		patch()
+
+		data, err := k8syaml.Marshal(obj)
+		if err != nil {
+			return fmt.Errorf("failed to encode %q: %w", name, err)
+		}
+
+		assets[i].Data = data
+
+		return nil
+	}
+
+	return fmt.Errorf("asset %q does not exist", name)
+}
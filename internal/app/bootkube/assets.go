@@ -18,19 +18,29 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-getter"
 	"github.com/hashicorp/go-multierror"
 	"github.com/kubernetes-sigs/bootkube/pkg/tlsutil"
 	"github.com/talos-systems/bootkube-plugin/pkg/asset"
+	"github.com/talos-systems/go-retry/retry"
 
 	tnet "github.com/talos-systems/net"
 
 	"github.com/talos-systems/talos/internal/app/bootkube/images"
+	"github.com/talos-systems/talos/internal/pkg/manifests"
 	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
+// manifestFetchTimeout is the time allowed to fetch and verify each of the
+// extra manifests.
+var manifestFetchTimeout = 5 * time.Minute
+
+var extraManifestsDirectory = filepath.Join(constants.AssetsDirectory, "manifests", "zzz-talos")
+
 // nolint: gocyclo
 func generateAssets(config config.Provider) (err error) {
 	// Ensure assets directory does not exist / is left over from a failed install
@@ -129,7 +139,7 @@ func generateAssets(config config.Provider) (err error) {
 
 	conf := asset.Config{
 		ClusterName:                config.Cluster().Name(),
-		APIServerExtraArgs:         config.Cluster().APIServer().ExtraArgs(),
+		APIServerExtraArgs:         apiServerExtraArgs(config.Cluster().APIServer()),
 		ControllerManagerExtraArgs: config.Cluster().ControllerManager().ExtraArgs(),
 		ProxyMode:                  config.Cluster().Proxy().Mode(),
 		ProxyExtraArgs:             config.Cluster().Proxy().ExtraArgs(),
@@ -157,19 +167,34 @@ func generateAssets(config config.Provider) (err error) {
 		ClusterDomain:              config.Cluster().Network().DNSDomain(),
 	}
 
-	if err = asset.Render(constants.AssetsDirectory, conf); err != nil {
+	assets, err := asset.NewDefaultAssets(conf)
+	if err != nil {
+		return err
+	}
+
+	if assets, err = customizeAPIServerAssets(assets, config.Cluster().APIServer()); err != nil {
+		return err
+	}
+
+	if err = assets.WriteFiles(constants.AssetsDirectory); err != nil {
 		return err
 	}
 
 	// If "custom" is the CNI, we expect the user to supply one or more urls that point to CNI yamls
 	if config.Cluster().Network().CNI().Name() == constants.CustomCNI {
-		if err = fetchManifests(config.Cluster().Network().CNI().URLs(), map[string]string{}); err != nil {
+		if err = fetchManifests(config.Cluster().Network().CNI().URLs(), map[string]string{}, config.Cluster().ExtraManifestChecksumMap()); err != nil {
 			return err
 		}
 	}
 
 	if len(config.Cluster().ExtraManifestURLs()) > 0 {
-		if err = fetchManifests(config.Cluster().ExtraManifestURLs(), config.Cluster().ExtraManifestHeaderMap()); err != nil {
+		if err = fetchManifests(config.Cluster().ExtraManifestURLs(), config.Cluster().ExtraManifestHeaderMap(), config.Cluster().ExtraManifestChecksumMap()); err != nil {
+			return err
+		}
+	}
+
+	if len(config.Cluster().InlineManifests()) > 0 {
+		if err = writeInlineManifests(config.Cluster().InlineManifests()); err != nil {
 			return err
 		}
 	}
@@ -194,7 +219,10 @@ func altNamesFromURLs(urls []string) *tlsutil.AltNames {
 }
 
 // fetchManifests will lay down manifests in the provided urls to the bootkube assets directory.
-func fetchManifests(urls []string, headers map[string]string) error {
+//
+// The manifests with the checksum are verified before they're laid down, the
+// manifests which failed to fetch are retried.
+func fetchManifests(urls []string, headers, checksums map[string]string) error {
 	ctx := context.Background()
 
 	var result *multierror.Error
@@ -208,36 +236,44 @@ func fetchManifests(urls []string, headers map[string]string) error {
 			continue
 		}
 
-		// Disable netrc since we don't have getent installed, and most likely
-		// never will.
-		httpGetter := &getter.HttpGetter{
-			Netrc:  false,
-			Client: http.DefaultClient,
+		// We will squirrel all user-supplied manifests into a `zzz-talos` directory.
+		// Bootkube applies manifests alphabetically, so pushing these into a subdir with this name
+		// allows us to ensure they're the last things that get applied and things like PSPs and whatnot are present
+		dst := filepath.Join(extraManifestsDirectory, fileName)
+
+		fetch := func(ctx context.Context) error {
+			return getManifest(ctx, url, dst, pwd, headers)
 		}
 
-		httpGetter.Header = make(http.Header)
+		if checksum, ok := checksums[url]; ok {
+			manifest := &v1alpha1.ManifestConfig{
+				ManifestName:   fileName,
+				ManifestURL:    url,
+				ManifestSHA256: checksum,
+			}
 
-		for k, v := range headers {
-			httpGetter.Header.Add(k, v)
+			fetch = func(ctx context.Context) error {
+				return writeManifest(ctx, manifest, dst, headers)
+			}
 		}
 
-		getter.Getters["http"] = httpGetter
-		getter.Getters["https"] = httpGetter
+		err = retry.Exponential(manifestFetchTimeout, retry.WithUnits(time.Second), retry.WithJitter(time.Second), retry.WithErrorLogging(true)).Retry(func() error {
+			fetchCtx, cancel := context.WithTimeout(ctx, manifestFetchTimeout)
+			defer cancel()
 
-		// We will squirrel all user-supplied manifests into a `zzz-talos` directory.
-		// Bootkube applies manifests alphabetically, so pushing these into a subdir with this name
-		// allows us to ensure they're the last things that get applied and things like PSPs and whatnot are present
-		client := &getter.Client{
-			Ctx:     ctx,
-			Src:     url,
-			Dst:     filepath.Join(constants.AssetsDirectory, "manifests", "zzz-talos", fileName),
-			Pwd:     pwd,
-			Mode:    getter.ClientModeFile,
-			Options: []getter.ClientOption{},
-		}
+			if err := fetch(fetchCtx); err != nil {
+				// the checksum mismatch is not transient, it's not retried
+				if errors.Is(err, manifests.ErrChecksumMismatch) {
+					return retry.UnexpectedError(err)
+				}
 
-		if err = client.Get(); err != nil {
-			result = multierror.Append(result, err)
+				return retry.ExpectedError(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed to fetch manifest %q: %w", url, err))
 			continue
 		}
 	}
@@ -245,6 +281,63 @@ func fetchManifests(urls []string, headers map[string]string) error {
 	return result.ErrorOrNil()
 }
 
+// getManifest lays down the manifest without the checksum.
+func getManifest(ctx context.Context, src, dst, pwd string, headers map[string]string) error {
+	// Disable netrc since we don't have getent installed, and most likely
+	// never will.
+	httpGetter := &getter.HttpGetter{
+		Netrc:  false,
+		Client: http.DefaultClient,
+	}
+
+	httpGetter.Header = make(http.Header)
+
+	for k, v := range headers {
+		httpGetter.Header.Add(k, v)
+	}
+
+	getter.Getters["http"] = httpGetter
+	getter.Getters["https"] = httpGetter
+
+	client := &getter.Client{
+		Ctx:     ctx,
+		Src:     src,
+		Dst:     dst,
+		Pwd:     pwd,
+		Mode:    getter.ClientModeFile,
+		Options: []getter.ClientOption{},
+	}
+
+	return client.Get()
+}
+
+// writeManifest lays down the manifest with the checksum, the manifest is
+// written only once it's verified.
+func writeManifest(ctx context.Context, manifest config.Manifest, dst string, headers map[string]string) error {
+	data, err := manifests.Fetch(ctx, manifest, headers)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(dst, data, 0o600)
+}
+
+// writeInlineManifests lays down the inline manifests along with the extra
+// manifests.
+func writeInlineManifests(inlineManifests []config.Manifest) error {
+	for _, manifest := range inlineManifests {
+		if err := writeManifest(context.Background(), manifest, filepath.Join(extraManifestsDirectory, manifest.Name()+".yaml"), nil); err != nil {
+			return fmt.Errorf("failed to write inline manifest %q: %w", manifest.Name(), err)
+		}
+	}
+
+	return nil
+}
+
 func splitCIDRs(cidrList string) (out []*net.IPNet, err error) {
 	for _, podCIDR := range strings.Split(cidrList, ",") {
 		_, cidr, err := net.ParseCIDR(podCIDR)
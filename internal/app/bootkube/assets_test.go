@@ -0,0 +1,134 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main //nolint: testpackage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const manifest = "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: ci\n"
+
+// manifestServer serves the manifest, failing the first GET requests with the
+// internal server error. Only the GET requests are counted, as the manifest
+// fetch without the checksum starts with the HEAD request.
+func manifestServer(failures int32) (*httptest.Server, *int32) {
+	var requests int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			return
+		}
+
+		if atomic.AddInt32(&requests, 1) <= failures {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		w.Write([]byte(manifest)) //nolint: errcheck
+	}))
+
+	return srv, &requests
+}
+
+func setupExtraManifestsDirectory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "talos")
+	require.NoError(t, err)
+
+	prevDir, prevTimeout := extraManifestsDirectory, manifestFetchTimeout
+
+	// the timeout is enough for a few retries
+	extraManifestsDirectory, manifestFetchTimeout = dir, 5*time.Second
+
+	t.Cleanup(func() {
+		extraManifestsDirectory, manifestFetchTimeout = prevDir, prevTimeout
+
+		os.RemoveAll(dir) //nolint: errcheck
+	})
+}
+
+func TestFetchManifestsChecksum(t *testing.T) {
+	setupExtraManifestsDirectory(t)
+
+	srv, requests := manifestServer(0)
+	defer srv.Close()
+
+	url := srv.URL + "/ci.yaml"
+	sum := sha256.Sum256([]byte(manifest))
+
+	require.NoError(t, fetchManifests([]string{url}, nil, map[string]string{url: hex.EncodeToString(sum[:])}))
+
+	contents, err := ioutil.ReadFile(filepath.Join(extraManifestsDirectory, "ci.yaml"))
+	require.NoError(t, err)
+
+	assert.Equal(t, manifest, string(contents))
+	assert.EqualValues(t, 1, atomic.LoadInt32(requests))
+}
+
+func TestFetchManifestsChecksumMismatch(t *testing.T) {
+	setupExtraManifestsDirectory(t)
+
+	srv, requests := manifestServer(0)
+	defer srv.Close()
+
+	url := srv.URL + "/ci.yaml"
+	sum := sha256.Sum256([]byte("tampered"))
+
+	err := fetchManifests([]string{url}, nil, map[string]string{url: hex.EncodeToString(sum[:])})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatch")
+
+	// the manifest is not laid down and the mismatch is not retried
+	_, err = os.Stat(filepath.Join(extraManifestsDirectory, "ci.yaml"))
+	assert.True(t, os.IsNotExist(err))
+	assert.EqualValues(t, 1, atomic.LoadInt32(requests))
+}
+
+func TestFetchManifestsRetry(t *testing.T) {
+	setupExtraManifestsDirectory(t)
+
+	srv, requests := manifestServer(1)
+	defer srv.Close()
+
+	url := srv.URL + "/ci.yaml"
+
+	require.NoError(t, fetchManifests([]string{url}, nil, nil))
+
+	contents, err := ioutil.ReadFile(filepath.Join(extraManifestsDirectory, "ci.yaml"))
+	require.NoError(t, err)
+
+	assert.Equal(t, manifest, string(contents))
+	assert.EqualValues(t, 2, atomic.LoadInt32(requests))
+}
+
+func TestFetchManifestsChecksumRetry(t *testing.T) {
+	setupExtraManifestsDirectory(t)
+
+	srv, requests := manifestServer(1)
+	defer srv.Close()
+
+	url := srv.URL + "/ci.yaml"
+	sum := sha256.Sum256([]byte(manifest))
+
+	require.NoError(t, fetchManifests([]string{url}, nil, map[string]string{url: hex.EncodeToString(sum[:])}))
+
+	contents, err := ioutil.ReadFile(filepath.Join(extraManifestsDirectory, "ci.yaml"))
+	require.NoError(t, err)
+
+	assert.Equal(t, manifest, string(contents))
+	assert.EqualValues(t, 2, atomic.LoadInt32(requests))
+}
@@ -29,8 +29,6 @@ func init() {
 	strict = flag.Bool("strict", true, "require all manifests to cleanly apply")
 	recover = flag.Bool("recover", false, "run recovery instead of generate")
 	recoverSource = flag.String("recover-source", "ETCD", "recovery source to use")
-
-	flag.Parse()
 }
 
 func run() error {
@@ -97,6 +95,9 @@ func run() error {
 }
 
 func main() {
+	// the flags are parsed in main, so that the package can be tested
+	flag.Parse()
+
 	util.InitLogs()
 
 	defer util.FlushLogs()
@@ -91,6 +91,18 @@ func RunInstallerContainer(disk, platform, ref string, reg config.Registries, op
 		args = append(args, []string{"--extra-kernel-arg", arg}...)
 	}
 
+	if options.Stage {
+		args = append(args, "--stage")
+	}
+
+	if options.BootAttempts > 0 {
+		args = append(args, "--boot-attempts="+strconv.Itoa(options.BootAttempts))
+	}
+
+	for _, label := range options.EncryptedPartitions {
+		args = append(args, []string{"--encrypted-partition", label}...)
+	}
+
 	specOpts := []oci.SpecOpts{
 		oci.WithImageConfig(img),
 		oci.WithProcessArgs(args...),
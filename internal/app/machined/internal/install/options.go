@@ -12,8 +12,13 @@ type Options struct {
 	Pull            bool
 	Force           bool
 	Upgrade         bool
+	Stage           bool
 	Zero            bool
 	ExtraKernelArgs []string
+	BootAttempts    int
+	// EncryptedPartitions lists the system partitions which are encrypted
+	// on the first boot, the installer leaves them unformatted.
+	EncryptedPartitions []string
 }
 
 // DefaultInstallOptions returns default options.
@@ -50,6 +55,15 @@ func WithUpgrade(b bool) Option {
 	}
 }
 
+// WithStage sets the stage option.
+func WithStage(b bool) Option {
+	return func(o *Options) error {
+		o.Stage = b
+
+		return nil
+	}
+}
+
 // WithZero sets the zero option.
 func WithZero(b bool) Option {
 	return func(o *Options) error {
@@ -67,3 +81,21 @@ func WithExtraKernelArgs(s []string) Option {
 		return nil
 	}
 }
+
+// WithBootAttempts sets the number of the upgraded image boot attempts.
+func WithBootAttempts(n int) Option {
+	return func(o *Options) error {
+		o.BootAttempts = n
+
+		return nil
+	}
+}
+
+// WithEncryptedPartitions sets the encrypted partitions.
+func WithEncryptedPartitions(labels []string) Option {
+	return func(o *Options) error {
+		o.EncryptedPartitions = labels
+
+		return nil
+	}
+}
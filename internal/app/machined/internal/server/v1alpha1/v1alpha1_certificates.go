@@ -0,0 +1,162 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/pkg/certificates"
+	"github.com/talos-systems/talos/internal/pkg/etcd"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	machinetype "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
+
+// apidRestartDelay gives apid the time to send the response before it is
+// restarted.
+const apidRestartDelay = time.Second
+
+// CertificateList implements the machine.MachineServer interface.
+func (s *Server) CertificateList(ctx context.Context, in *machine.CertificateListRequest) (*machine.CertificateListResponse, error) {
+	certs, err := certificates.List(ctx, s.Controller.Runtime().Config())
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*machine.Certificate, 0, len(certs))
+
+	for _, crt := range certs {
+		notBefore, err := ptypes.TimestampProto(crt.NotBefore)
+		if err != nil {
+			return nil, err
+		}
+
+		notAfter, err := ptypes.TimestampProto(crt.NotAfter)
+		if err != nil {
+			return nil, err
+		}
+
+		ips := make([]string, 0, len(crt.IPAddresses))
+
+		for _, ip := range crt.IPAddresses {
+			ips = append(ips, ip.String())
+		}
+
+		result = append(result, &machine.Certificate{
+			Name:        crt.Name,
+			Path:        crt.Path,
+			Subject:     crt.Subject.String(),
+			Issuer:      crt.Issuer.String(),
+			DnsNames:    crt.DNSNames,
+			IpAddresses: ips,
+			NotBefore:   notBefore,
+			NotAfter:    notAfter,
+			IsCa:        crt.IsCA,
+			AutoRenewed: crt.AutoRenewed,
+		})
+	}
+
+	return &machine.CertificateListResponse{
+		Messages: []*machine.CertificateList{
+			{
+				Certificates: result,
+			},
+		},
+	}, nil
+}
+
+// CertificateRotate implements the machine.MachineServer interface.
+//
+// The leaf certificates are issued from the CAs in the machine config when the
+// services using them start, so the services are restarted: etcd issues the
+// peer certificate, apid requests a new certificate from trustd.
+func (s *Server) CertificateRotate(ctx context.Context, in *machine.CertificateRotateRequest) (*machine.CertificateRotateResponse, error) {
+	var restarted []string
+
+	if s.Controller.Runtime().Config().Machine().Type() != machinetype.TypeJoin {
+		if !in.GetForce() {
+			if err := s.checkEtcdRestart(ctx); err != nil {
+				return nil, status.Error(codes.FailedPrecondition, err.Error())
+			}
+		}
+
+		log.Printf("restarting etcd to rotate the peer certificate")
+
+		if err := system.Services(nil).Stop(ctx, "etcd"); err != nil {
+			return nil, fmt.Errorf("failed to stop etcd: %w", err)
+		}
+
+		if err := system.Services(nil).Start("etcd"); err != nil {
+			return nil, fmt.Errorf("failed to start etcd: %w", err)
+		}
+
+		// the nodes are rotated one by one, so etcd should be back before the
+		// next node is rotated
+		if err := system.WaitForService(system.StateEventUp, "etcd").Wait(ctx); err != nil {
+			return nil, fmt.Errorf("failed waiting for etcd: %w", err)
+		}
+
+		restarted = append(restarted, "etcd")
+	}
+
+	// the request is served via apid, so it is restarted once the response is
+	// sent
+	go func() {
+		time.Sleep(apidRestartDelay)
+
+		log.Printf("restarting apid to rotate the certificate")
+
+		if err := system.Services(nil).Stop(context.Background(), "apid"); err != nil {
+			log.Printf("failed to stop apid: %s", err)
+
+			return
+		}
+
+		if err := system.Services(nil).Start("apid"); err != nil {
+			log.Printf("failed to start apid: %s", err)
+		}
+	}()
+
+	restarted = append(restarted, "apid")
+
+	return &machine.CertificateRotateResponse{
+		Messages: []*machine.CertificateRotate{
+			{
+				Services: restarted,
+			},
+		},
+	}, nil
+}
+
+// checkEtcdRestart verifies that the etcd cluster keeps the quorum while the
+// local member is restarted.
+func (s *Server) checkEtcdRestart(ctx context.Context) error {
+	client, err := s.etcdClient()
+	if err != nil {
+		return err
+	}
+
+	// nolint: errcheck
+	defer client.Close()
+
+	resp, err := client.MemberList(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the single member cluster is unavailable while etcd restarts anyway
+	if len(resp.Members) == 1 {
+		return nil
+	}
+
+	return etcd.CheckQuorum(resp.Members, etcd.MemberHealth(ctx, client, resp.Members), resp.Header.MemberId)
+}
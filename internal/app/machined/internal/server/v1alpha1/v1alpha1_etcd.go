@@ -0,0 +1,477 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
+	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/etcdserver/etcdserverpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/pkg/etcd"
+	"github.com/talos-systems/talos/pkg/chunker/stream"
+	"github.com/talos-systems/talos/pkg/machinery/api/common"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	machinetype "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// EtcdSnapshot implements the machine.MachineServer interface.
+func (s *Server) EtcdSnapshot(in *machine.EtcdSnapshotRequest, srv machine.MachineService_EtcdSnapshotServer) error {
+	if s.Controller.Runtime().Config().Machine().Type() == machinetype.TypeJoin {
+		return status.Error(codes.FailedPrecondition, "etcd snapshot can only be taken on a control plane node")
+	}
+
+	client, err := etcd.NewClient([]string{"127.0.0.1:2379"})
+	if err != nil {
+		return fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	// nolint: errcheck
+	defer client.Close()
+
+	ctx, ctxCancel := context.WithCancel(srv.Context())
+	defer ctxCancel()
+
+	rd, err := client.Snapshot(ctx)
+	if err != nil {
+		return fmt.Errorf("failed reading etcd snapshot: %w", err)
+	}
+
+	// nolint: errcheck
+	defer rd.Close()
+
+	chunker := stream.NewChunker(ctx, rd)
+	chunkCh := chunker.Read()
+
+	for data := range chunkCh {
+		if err = srv.SendMsg(&common.Data{Bytes: data}); err != nil {
+			ctxCancel()
+		}
+	}
+
+	return nil
+}
+
+// EtcdRecover implements the machine.MachineServer interface.
+//
+// The uploaded snapshot is stored until the etcd is bootstrapped with the
+// recover_etcd flag.
+func (s *Server) EtcdRecover(srv machine.MachineService_EtcdRecoverServer) error {
+	if s.Controller.Runtime().Config().Machine().Type() == machinetype.TypeJoin {
+		return status.Error(codes.FailedPrecondition, "etcd can only be recovered on a control plane node")
+	}
+
+	log.Printf("etcd recover snapshot upload received")
+
+	f, err := ioutil.TempFile(filepath.Dir(constants.EtcdRecoverySnapshotPath), filepath.Base(constants.EtcdRecoverySnapshotPath))
+	if err != nil {
+		return err
+	}
+
+	// nolint: errcheck
+	defer os.Remove(f.Name())
+
+	// nolint: errcheck
+	defer f.Close()
+
+	for {
+		var in *common.Data
+
+		if in, err = srv.Recv(); err != nil {
+			if err == io.EOF {
+				break
+			}
+
+			return err
+		}
+
+		if _, err = f.Write(in.GetBytes()); err != nil {
+			return fmt.Errorf("error writing snapshot: %w", err)
+		}
+	}
+
+	// the snapshots copied from the data directory don't have the hash, they
+	// can be only restored with the hash check skipped
+	if err = etcd.VerifySnapshot(f); err != nil && !errors.Is(err, etcd.ErrNoSnapshotHash) {
+		return status.Errorf(codes.InvalidArgument, "error verifying snapshot: %s", err)
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	if err = os.Rename(f.Name(), constants.EtcdRecoverySnapshotPath); err != nil {
+		return err
+	}
+
+	return srv.SendAndClose(&machine.EtcdRecoverResponse{
+		Messages: []*machine.EtcdRecover{
+			{},
+		},
+	})
+}
+
+// etcdClient returns the client of the local etcd member.
+func (s *Server) etcdClient() (*clientv3.Client, error) {
+	if s.Controller.Runtime().Config().Machine().Type() == machinetype.TypeJoin {
+		return nil, status.Error(codes.FailedPrecondition, "etcd is only available on the control plane nodes")
+	}
+
+	client, err := etcd.NewClient([]string{"127.0.0.1:2379"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	return client, nil
+}
+
+// EtcdMemberList implements the machine.MachineServer interface.
+func (s *Server) EtcdMemberList(ctx context.Context, in *machine.EtcdMemberListRequest) (*machine.EtcdMemberListResponse, error) {
+	client, err := s.etcdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer client.Close()
+
+	resp, err := client.MemberList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := client.Status(ctx, client.Endpoints()[0])
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*machine.EtcdMember, 0, len(resp.Members))
+
+	for _, member := range resp.Members {
+		members = append(members, &machine.EtcdMember{
+			Id:         member.ID,
+			Hostname:   member.Name,
+			PeerUrls:   member.PeerURLs,
+			ClientUrls: member.ClientURLs,
+			IsLearner:  member.IsLearner,
+			IsLeader:   member.ID == st.Leader,
+		})
+	}
+
+	return &machine.EtcdMemberListResponse{
+		Messages: []*machine.EtcdMembers{
+			{
+				Members: members,
+			},
+		},
+	}, nil
+}
+
+// EtcdRemoveMember implements the machine.MachineServer interface.
+//
+// The member of the node which died permanently is removed from the cluster,
+// the node itself should use EtcdLeaveCluster.
+func (s *Server) EtcdRemoveMember(ctx context.Context, in *machine.EtcdRemoveMemberRequest) (*machine.EtcdRemoveMemberResponse, error) {
+	client, err := s.etcdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer client.Close()
+
+	resp, err := client.MemberList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := etcd.FindMember(resp.Members, in.GetMember())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if member.ID == resp.Header.MemberId {
+		return nil, status.Error(codes.InvalidArgument, "the node can't remove its own member, use EtcdLeaveCluster instead")
+	}
+
+	if !in.GetForce() {
+		if err = etcd.CheckQuorum(resp.Members, etcd.MemberHealth(ctx, client, resp.Members), member.ID); err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+	}
+
+	log.Printf("removing etcd member %q (%x)", member.Name, member.ID)
+
+	if _, err = client.MemberRemove(ctx, member.ID); err != nil {
+		return nil, fmt.Errorf("failed to remove member %q: %w", member.Name, err)
+	}
+
+	return &machine.EtcdRemoveMemberResponse{
+		Messages: []*machine.EtcdRemoveMember{
+			{},
+		},
+	}, nil
+}
+
+// EtcdLeaveCluster implements the machine.MachineServer interface.
+//
+// The node removes its own member, etcd is stopped and its data is wiped.
+func (s *Server) EtcdLeaveCluster(ctx context.Context, in *machine.EtcdLeaveClusterRequest) (*machine.EtcdLeaveClusterResponse, error) {
+	client, err := s.etcdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer client.Close()
+
+	resp, err := client.MemberList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id := resp.Header.MemberId
+
+	if !in.GetForce() {
+		if err = etcd.CheckQuorum(resp.Members, etcd.MemberHealth(ctx, client, resp.Members), id); err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+	}
+
+	// the leadership is handed over so that the cluster doesn't wait for the
+	// election
+	if _, err = forfeitLeadership(ctx, client); err != nil && !in.GetForce() {
+		return nil, err
+	}
+
+	log.Printf("leaving etcd cluster")
+
+	if _, err = client.MemberRemove(ctx, id); err != nil {
+		return nil, fmt.Errorf("failed to leave etcd cluster: %w", err)
+	}
+
+	if err = system.Services(nil).Stop(ctx, "etcd"); err != nil {
+		return nil, fmt.Errorf("failed to stop etcd: %w", err)
+	}
+
+	// once the member is removed, the data is no longer valid
+	if err = os.RemoveAll(constants.EtcdDataPath); err != nil {
+		return nil, err
+	}
+
+	return &machine.EtcdLeaveClusterResponse{
+		Messages: []*machine.EtcdLeaveCluster{
+			{},
+		},
+	}, nil
+}
+
+// EtcdForfeitLeadership implements the machine.MachineServer interface.
+func (s *Server) EtcdForfeitLeadership(ctx context.Context, in *machine.EtcdForfeitLeadershipRequest) (*machine.EtcdForfeitLeadershipResponse, error) {
+	client, err := s.etcdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer client.Close()
+
+	leader, err := forfeitLeadership(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &machine.EtcdForfeitLeadershipResponse{
+		Messages: []*machine.EtcdForfeitLeadership{
+			{
+				Member: leader,
+			},
+		},
+	}, nil
+}
+
+// forfeitLeadership moves the leadership to the healthy voting member if the
+// local member is the leader, it returns the name of the leader.
+func forfeitLeadership(ctx context.Context, client *clientv3.Client) (string, error) {
+	st, err := client.Status(ctx, client.Endpoints()[0])
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.MemberList(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if st.Leader != st.Header.MemberId {
+		for _, member := range resp.Members {
+			if member.ID == st.Leader {
+				return member.Name, nil
+			}
+		}
+
+		return "", nil
+	}
+
+	healthy := etcd.MemberHealth(ctx, client, resp.Members)
+
+	for _, member := range resp.Members {
+		if member.ID == st.Leader || member.IsLearner || !healthy[member.ID] {
+			continue
+		}
+
+		log.Printf("moving etcd leadership to %q", member.Name)
+
+		if _, err = client.MoveLeader(ctx, member.ID); err != nil {
+			return "", fmt.Errorf("failed to move leadership to %q: %w", member.Name, err)
+		}
+
+		return member.Name, nil
+	}
+
+	return "", status.Error(codes.FailedPrecondition, "no healthy member to move the etcd leadership to")
+}
+
+// EtcdStatus implements the machine.MachineServer interface.
+func (s *Server) EtcdStatus(ctx context.Context, in *machine.EtcdStatusRequest) (*machine.EtcdStatusResponse, error) {
+	client, err := s.etcdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer client.Close()
+
+	quota, err := etcd.QuotaBackendBytes(s.Controller.Runtime().Config().Cluster().Etcd().ExtraArgs())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Status(ctx, client.Endpoints()[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return &machine.EtcdStatusResponse{
+		Messages: []*machine.EtcdStatus{
+			{
+				MemberStatus: &machine.EtcdMemberStatus{
+					MemberId:          resp.Header.MemberId,
+					ProtocolVersion:   resp.Version,
+					DbSize:            resp.DbSize,
+					DbSizeInUse:       resp.DbSizeInUse,
+					Leader:            resp.Leader,
+					RaftIndex:         resp.RaftIndex,
+					RaftTerm:          resp.RaftTerm,
+					RaftAppliedIndex:  resp.RaftAppliedIndex,
+					Errors:            resp.Errors,
+					IsLearner:         resp.IsLearner,
+					QuotaBackendBytes: quota,
+				},
+			},
+		},
+	}, nil
+}
+
+// EtcdAlarmList implements the machine.MachineServer interface.
+func (s *Server) EtcdAlarmList(ctx context.Context, in *machine.EtcdAlarmListRequest) (*machine.EtcdAlarmListResponse, error) {
+	client, err := s.etcdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer client.Close()
+
+	resp, err := client.AlarmList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &machine.EtcdAlarmListResponse{
+		Messages: []*machine.EtcdAlarm{
+			{
+				MemberAlarms: memberAlarms(resp.Alarms),
+			},
+		},
+	}, nil
+}
+
+// EtcdAlarmDisarm implements the machine.MachineServer interface.
+func (s *Server) EtcdAlarmDisarm(ctx context.Context, in *machine.EtcdAlarmDisarmRequest) (*machine.EtcdAlarmDisarmResponse, error) {
+	client, err := s.etcdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer client.Close()
+
+	// the empty alarm member disarms all the alarms
+	resp, err := client.AlarmDisarm(ctx, &clientv3.AlarmMember{})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("disarmed %d etcd alarm(s)", len(resp.Alarms))
+
+	return &machine.EtcdAlarmDisarmResponse{
+		Messages: []*machine.EtcdAlarmDisarm{
+			{
+				MemberAlarms: memberAlarms(resp.Alarms),
+			},
+		},
+	}, nil
+}
+
+// EtcdDefragment implements the machine.MachineServer interface.
+//
+// The member doesn't serve the requests while it is defragmented, so the
+// members should be defragmented one at a time.
+func (s *Server) EtcdDefragment(ctx context.Context, in *machine.EtcdDefragmentRequest) (*machine.EtcdDefragmentResponse, error) {
+	client, err := s.etcdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer client.Close()
+
+	log.Printf("defragmenting etcd member")
+
+	if _, err = client.Defragment(ctx, client.Endpoints()[0]); err != nil {
+		return nil, fmt.Errorf("failed to defragment etcd member: %w", err)
+	}
+
+	return &machine.EtcdDefragmentResponse{
+		Messages: []*machine.EtcdDefragment{
+			{},
+		},
+	}, nil
+}
+
+func memberAlarms(alarms []*etcdserverpb.AlarmMember) []*machine.EtcdMemberAlarm {
+	result := make([]*machine.EtcdMemberAlarm, 0, len(alarms))
+
+	for _, alarm := range alarms {
+		result = append(result, &machine.EtcdMemberAlarm{
+			MemberId: alarm.MemberID,
+			Alarm:    machine.EtcdMemberAlarm_AlarmType(alarm.Alarm),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,127 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/internal/pkg/meta"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+// MetaList implements the machine.MachineServer interface.
+func (s *Server) MetaList(ctx context.Context, in *empty.Empty) (*machine.MetaListResponse, error) {
+	values, err := meta.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	list := &machine.MetaList{
+		Entries: make([]*machine.MetaEntry, 0, len(values)),
+	}
+
+	for _, key := range values.Keys() {
+		list.Entries = append(list.Entries, &machine.MetaEntry{
+			Key:   key,
+			Value: values[key],
+		})
+	}
+
+	return &machine.MetaListResponse{
+		Messages: []*machine.MetaList{
+			list,
+		},
+	}, nil
+}
+
+// MetaGet implements the machine.MachineServer interface.
+func (s *Server) MetaGet(ctx context.Context, in *machine.MetaGetRequest) (*machine.MetaGetResponse, error) {
+	values, err := meta.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := values[in.GetKey()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "key %q is not found", in.GetKey())
+	}
+
+	return &machine.MetaGetResponse{
+		Messages: []*machine.MetaGet{
+			{
+				Entry: &machine.MetaEntry{
+					Key:   in.GetKey(),
+					Value: value,
+				},
+			},
+		},
+	}, nil
+}
+
+// MetaSet implements the machine.MachineServer interface.
+func (s *Server) MetaSet(ctx context.Context, in *machine.MetaSetRequest) (*machine.MetaSetResponse, error) {
+	if err := checkMetaKey(in.GetEntry().GetKey()); err != nil {
+		return nil, err
+	}
+
+	err := meta.Update(func(values meta.Values) error {
+		values[in.GetEntry().GetKey()] = in.GetEntry().GetValue()
+
+		if err := values.Validate(); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &machine.MetaSetResponse{
+		Messages: []*machine.MetaSet{
+			{},
+		},
+	}, nil
+}
+
+// MetaDelete implements the machine.MachineServer interface.
+func (s *Server) MetaDelete(ctx context.Context, in *machine.MetaDeleteRequest) (*machine.MetaDeleteResponse, error) {
+	if err := checkMetaKey(in.GetKey()); err != nil {
+		return nil, err
+	}
+
+	err := meta.Update(func(values meta.Values) error {
+		if _, ok := values[in.GetKey()]; !ok {
+			return status.Errorf(codes.NotFound, "key %q is not found", in.GetKey())
+		}
+
+		delete(values, in.GetKey())
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &machine.MetaDeleteResponse{
+		Messages: []*machine.MetaDelete{
+			{},
+		},
+	}, nil
+}
+
+// checkMetaKey rejects the keys reserved for Talos, as Talos applies them
+// without validating the values.
+func checkMetaKey(key string) error {
+	if meta.IsReserved(key) {
+		return status.Errorf(codes.InvalidArgument, "key %q is reserved, the keys prefixed with %q can't be changed", key, meta.ReservedPrefix)
+	}
+
+	return nil
+}
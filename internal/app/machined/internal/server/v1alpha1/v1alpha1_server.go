@@ -13,10 +13,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -25,14 +27,18 @@ import (
 	"github.com/containerd/containerd/namespaces"
 	"github.com/containerd/containerd/oci"
 	criconstants "github.com/containerd/cri/pkg/constants"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/hashicorp/go-multierror"
 	"github.com/prometheus/procfs"
 	"github.com/rs/xid"
 	"golang.org/x/sys/unix"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/grub"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
 	"github.com/talos-systems/talos/internal/pkg/containers"
@@ -40,8 +46,11 @@ import (
 	"github.com/talos-systems/talos/internal/pkg/containers/cri"
 	"github.com/talos-systems/talos/internal/pkg/containers/image"
 	"github.com/talos-systems/talos/internal/pkg/etcd"
+	"github.com/talos-systems/talos/internal/pkg/inventory"
+	"github.com/talos-systems/talos/internal/pkg/kernel"
 	"github.com/talos-systems/talos/internal/pkg/kmsg"
 	"github.com/talos-systems/talos/internal/pkg/kubeconfig"
+	"github.com/talos-systems/talos/internal/pkg/meta"
 	"github.com/talos-systems/talos/pkg/archiver"
 	"github.com/talos-systems/talos/pkg/chunker"
 	"github.com/talos-systems/talos/pkg/chunker/stream"
@@ -63,6 +72,9 @@ type Server struct {
 	Controller runtime.Controller
 
 	server *grpc.Server
+
+	// upgradeMu serializes the checks and the updates of the staged upgrade.
+	upgradeMu sync.Mutex
 }
 
 // Register implements the factory.Registrator interface.
@@ -84,7 +96,7 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 		if err = s.Controller.Run(runtime.SequenceApplyConfiguration, in); err != nil {
 			log.Println("apply configuration failed:", err)
 
-			if err != runtime.ErrLocked {
+			if err != runtime.ErrLocked && !errors.Is(err, runtime.ErrCanceled) {
 				s.server.GracefulStop()
 			}
 		}
@@ -109,7 +121,7 @@ func (s *Server) Reboot(ctx context.Context, in *empty.Empty) (reply *machine.Re
 		if err := s.Controller.Run(runtime.SequenceReboot, in); err != nil {
 			log.Println("reboot failed:", err)
 
-			if err != runtime.ErrLocked {
+			if err != runtime.ErrLocked && !errors.Is(err, runtime.ErrCanceled) {
 				// NB: We stop the gRPC server since a failed sequence triggers a
 				// reboot.
 				s.server.GracefulStop()
@@ -133,7 +145,7 @@ func (s *Server) Rollback(ctx context.Context, in *machine.RollbackRequest) (rep
 	log.Printf("rollback via API received")
 
 	grub := &grub.Grub{
-		BootDisk: s.Controller.Runtime().Config().Machine().Install().Disk(),
+		BootDisk: runtime.SystemDisk(s.Controller.Runtime()),
 	}
 
 	_, next, err := grub.Labels()
@@ -153,7 +165,7 @@ func (s *Server) Rollback(ctx context.Context, in *machine.RollbackRequest) (rep
 		if err := s.Controller.Run(runtime.SequenceReboot, in, runtime.WithForce()); err != nil {
 			log.Println("reboot failed:", err)
 
-			if err != runtime.ErrLocked {
+			if err != runtime.ErrLocked && !errors.Is(err, runtime.ErrCanceled) {
 				// NB: We stop the gRPC server since a failed sequence triggers a
 				// reboot.
 				s.server.GracefulStop()
@@ -180,11 +192,17 @@ func (s *Server) Bootstrap(ctx context.Context, in *machine.BootstrapRequest) (r
 		return nil, fmt.Errorf("bootstrap can only be performed on a control plane node")
 	}
 
+	if in.GetRecoverEtcd() {
+		if _, err = os.Stat(constants.EtcdRecoverySnapshotPath); err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "etcd snapshot is not available, it should be uploaded with EtcdRecover: %s", err)
+		}
+	}
+
 	go func() {
 		if err := s.Controller.Run(runtime.SequenceBootstrap, in); err != nil {
 			log.Println("bootstrap failed:", err)
 
-			if err != runtime.ErrLocked {
+			if err != runtime.ErrLocked && !errors.Is(err, runtime.ErrCanceled) {
 				// NB: We stop the gRPC server since a failed sequence triggers a
 				// reboot.
 				s.server.GracefulStop()
@@ -211,7 +229,7 @@ func (s *Server) Shutdown(ctx context.Context, in *empty.Empty) (reply *machine.
 		if err := s.Controller.Run(runtime.SequenceShutdown, in); err != nil {
 			log.Println("shutdown failed:", err)
 
-			if err != runtime.ErrLocked {
+			if err != runtime.ErrLocked && !errors.Is(err, runtime.ErrCanceled) {
 				// NB: We stop the gRPC server since a failed sequence triggers a
 				// reboot.
 				s.server.GracefulStop()
@@ -234,12 +252,51 @@ func (s *Server) Shutdown(ctx context.Context, in *empty.Empty) (reply *machine.
 func (s *Server) Upgrade(ctx context.Context, in *machine.UpgradeRequest) (reply *machine.UpgradeResponse, err error) {
 	log.Printf("upgrade request received")
 
+	exclusive := 0
+
+	for _, set := range []bool{in.GetStage(), in.GetCommit(), in.GetDiscard()} {
+		if set {
+			exclusive++
+		}
+	}
+
+	if exclusive > 1 {
+		return nil, fmt.Errorf("stage, commit and discard are mutually exclusive")
+	}
+
+	if in.GetCommit() {
+		return s.commitUpgrade(in)
+	}
+
+	if in.GetDiscard() {
+		return s.discardUpgrade()
+	}
+
+	s.upgradeMu.Lock()
+	defer s.upgradeMu.Unlock()
+
+	// the installer replaces the label which isn't the boot default, once the
+	// upgrade is staged that is the running label
+	if err = s.checkStagedUpgrade(); err != nil {
+		return nil, err
+	}
+
 	log.Printf("validating %q", in.GetImage())
 
-	if err = pullAndValidateInstallerImage(ctx, s.Controller.Runtime().Config().Machine().Registries(), in.GetImage()); err != nil {
+	if in.GetImported() {
+		err = validateImportedInstallerImage(ctx, in.GetImage())
+	} else {
+		err = pullAndValidateInstallerImage(ctx, s.Controller.Runtime().Config().Machine().Registries(), in.GetImage())
+	}
+
+	if err != nil {
 		return nil, fmt.Errorf("error validating installer image %q: %w", in.GetImage(), err)
 	}
 
+	if in.GetStage() {
+		return s.stageUpgrade(in)
+	}
+
 	if err = etcd.ValidateForUpgrade(s.Controller.Runtime().Config(), in.GetPreserve()); err != nil {
 		return nil, fmt.Errorf("error validating etcd for upgrade: %w", err)
 	}
@@ -248,7 +305,7 @@ func (s *Server) Upgrade(ctx context.Context, in *machine.UpgradeRequest) (reply
 		if err := s.Controller.Run(runtime.SequenceUpgrade, in); err != nil {
 			log.Println("upgrade failed:", err)
 
-			if err != runtime.ErrLocked {
+			if err != runtime.ErrLocked && !errors.Is(err, runtime.ErrCanceled) {
 				// NB: We stop the gRPC server since a failed sequence triggers a
 				// reboot.
 				s.server.GracefulStop()
@@ -267,6 +324,276 @@ func (s *Server) Upgrade(ctx context.Context, in *machine.UpgradeRequest) (reply
 	return reply, nil
 }
 
+// stageUpgrade installs the upgrade while the node keeps running. The
+// installer image has already been pulled, so the install takes no downtime.
+//
+// The caller holds upgradeMu.
+func (s *Server) stageUpgrade(in *machine.UpgradeRequest) (*machine.UpgradeResponse, error) {
+	// the status is set before the sequence starts, so that the concurrent
+	// requests are rejected
+	s.Controller.Runtime().State().Machine().SetStagedUpgrade(&machine.UpgradeStatus{
+		Stage:   machine.UpgradeStatus_STAGING,
+		Image:   in.GetImage(),
+		Updated: ptypes.TimestampNow(),
+	})
+
+	go func() {
+		// NB: The staging failures are recorded in the upgrade status by the
+		// sequence itself, an error here means that the sequence didn't run.
+		if err := s.Controller.Run(runtime.SequenceUpgrade, in); err != nil {
+			log.Println("upgrade staging failed:", err)
+
+			s.Controller.Runtime().State().Machine().SetStagedUpgrade(&machine.UpgradeStatus{
+				Stage:   machine.UpgradeStatus_FAILED,
+				Image:   in.GetImage(),
+				Error:   err.Error(),
+				Updated: ptypes.TimestampNow(),
+			})
+		}
+	}()
+
+	return &machine.UpgradeResponse{
+		Messages: []*machine.Upgrade{
+			{
+				Ack: "Upgrade staging started",
+			},
+		},
+	}, nil
+}
+
+// commitUpgrade reboots the node into the staged upgrade.
+func (s *Server) commitUpgrade(in *machine.UpgradeRequest) (*machine.UpgradeResponse, error) {
+	status := s.Controller.Runtime().State().Machine().StagedUpgrade()
+	if status == nil || status.GetStage() != machine.UpgradeStatus_STAGED {
+		return nil, fmt.Errorf("no staged upgrade to commit")
+	}
+
+	// the staged upgrade always preserves the data
+	if err := etcd.ValidateForUpgrade(s.Controller.Runtime().Config(), true); err != nil {
+		return nil, fmt.Errorf("error validating etcd for upgrade: %w", err)
+	}
+
+	go func() {
+		if err := s.Controller.Run(runtime.SequenceUpgrade, in); err != nil {
+			log.Println("upgrade commit failed:", err)
+
+			if err != runtime.ErrLocked && !errors.Is(err, runtime.ErrCanceled) {
+				// NB: We stop the gRPC server since a failed sequence triggers a
+				// reboot.
+				s.server.GracefulStop()
+			}
+		}
+	}()
+
+	return &machine.UpgradeResponse{
+		Messages: []*machine.Upgrade{
+			{
+				Ack: fmt.Sprintf("Upgrade to %q committed", status.GetImage()),
+			},
+		},
+	}, nil
+}
+
+// discardUpgrade reverts the boot default to the running label, the staged
+// label is replaced by the next upgrade.
+func (s *Server) discardUpgrade() (*machine.UpgradeResponse, error) {
+	s.upgradeMu.Lock()
+	defer s.upgradeMu.Unlock()
+
+	status := s.Controller.Runtime().State().Machine().StagedUpgrade()
+	if status != nil && status.GetStage() == machine.UpgradeStatus_STAGING {
+		return nil, fmt.Errorf("upgrade to %q is being staged", status.GetImage())
+	}
+
+	meta, err := bootloader.NewMeta()
+	if err != nil {
+		return nil, err
+	}
+	// nolint: errcheck
+	defer meta.Close()
+
+	label, ok := meta.ReadTag(bootloader.AdvUpgradeTrial)
+	if !ok {
+		return nil, fmt.Errorf("no staged upgrade to discard")
+	}
+
+	if err = meta.Revert(); err != nil {
+		return nil, fmt.Errorf("failed to revert bootloader: %w", err)
+	}
+
+	s.Controller.Runtime().State().Machine().SetStagedUpgrade(nil)
+
+	return &machine.UpgradeResponse{
+		Messages: []*machine.Upgrade{
+			{
+				Ack: fmt.Sprintf("Staged upgrade to label %q discarded", label),
+			},
+		},
+	}, nil
+}
+
+// checkStagedUpgrade rejects the upgrade while another one is staged.
+//
+// The staged label is recorded in META as booted on trial. The trial is
+// confirmed while booting, so once the node is running the trial means that
+// the upgrade is staged even if machined doesn't know about it.
+func (s *Server) checkStagedUpgrade() error {
+	status := s.Controller.Runtime().State().Machine().StagedUpgrade()
+
+	// there is no bootloader in the container mode
+	if s.Controller.Runtime().State().Platform().Mode() == runtime.ModeContainer {
+		return stagedUpgradeConflict(status, "")
+	}
+
+	meta, err := bootloader.NewMeta()
+	if err != nil {
+		return err
+	}
+	// nolint: errcheck
+	defer meta.Close()
+
+	trial, _ := meta.ReadTag(bootloader.AdvUpgradeTrial)
+
+	return stagedUpgradeConflict(status, trial)
+}
+
+func stagedUpgradeConflict(status *machine.UpgradeStatus, trial string) error {
+	switch status.GetStage() { //nolint: exhaustive
+	case machine.UpgradeStatus_STAGING:
+		return fmt.Errorf("upgrade to %q is already being staged", status.GetImage())
+	case machine.UpgradeStatus_STAGED:
+		return fmt.Errorf("upgrade to %q is staged, it should be committed or discarded first", status.GetImage())
+	}
+
+	if trial != "" {
+		return fmt.Errorf("upgrade to label %q is staged, it should be committed or discarded first", trial)
+	}
+
+	return nil
+}
+
+// ImageImportStream imports the installer image archive streamed by the client
+// into the system containerd, so that the node can be upgraded without access
+// to the registry.
+func (s *Server) ImageImportStream(srv machine.MachineService_ImageImportStreamServer) error {
+	in, err := srv.Recv()
+	if err != nil {
+		return err
+	}
+
+	ref := in.GetImage()
+	if ref == "" {
+		return fmt.Errorf("image reference is required")
+	}
+
+	log.Printf("image import request received for %q", ref)
+
+	// the archive is spooled to the disk, as it might not fit into the memory
+	f, err := ioutil.TempFile(constants.EphemeralMountPoint, "image-import")
+	if err != nil {
+		return err
+	}
+
+	// nolint: errcheck
+	defer os.Remove(f.Name())
+
+	// nolint: errcheck
+	defer f.Close()
+
+	for {
+		if _, err = f.Write(in.GetData()); err != nil {
+			return fmt.Errorf("error writing image archive: %w", err)
+		}
+
+		if in, err = srv.Recv(); err != nil {
+			if err == io.EOF {
+				break
+			}
+
+			return err
+		}
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	if err = image.Import(srv.Context(), f.Name(), ref); err != nil {
+		return fmt.Errorf("error importing image archive: %w", err)
+	}
+
+	containerdctx := namespaces.WithNamespace(srv.Context(), constants.SystemContainerdNamespace)
+
+	client, err := containerd.New(constants.SystemContainerdAddress)
+	if err != nil {
+		return err
+	}
+
+	// nolint: errcheck
+	defer client.Close()
+
+	img, err := client.GetImage(containerdctx, ref)
+	if err != nil {
+		return err
+	}
+
+	if err = validateInstallerImage(containerdctx, client, img); err != nil {
+		return fmt.Errorf("error validating installer image %q: %w", ref, err)
+	}
+
+	log.Printf("imported %q (%s)", ref, img.Target().Digest)
+
+	return srv.Send(&machine.ImageImport{
+		Image:  ref,
+		Digest: img.Target().Digest.String(),
+	})
+}
+
+// ImagePull pulls the image into the CRI containerd, so that it's available to
+// kubelet, e.g. before the Kubernetes control plane is upgraded.
+func (s *Server) ImagePull(ctx context.Context, in *machine.ImagePullRequest) (*machine.ImagePullResponse, error) {
+	ref := in.GetReference()
+	if ref == "" {
+		return nil, status.Error(codes.InvalidArgument, "image reference is required")
+	}
+
+	client, err := containerd.New(constants.ContainerdAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer client.Close()
+
+	log.Printf("pulling image %q", ref)
+
+	containerdctx := namespaces.WithNamespace(ctx, "k8s.io")
+
+	if _, err = image.Pull(containerdctx, s.Controller.Runtime().Config().Machine().Registries(), client, ref); err != nil {
+		return nil, err
+	}
+
+	return &machine.ImagePullResponse{
+		Messages: []*machine.ImagePull{
+			{},
+		},
+	}, nil
+}
+
+// UpgradeStatus returns the status of the staged upgrade.
+func (s *Server) UpgradeStatus(ctx context.Context, in *empty.Empty) (*machine.UpgradeStatusResponse, error) {
+	status := s.Controller.Runtime().State().Machine().StagedUpgrade()
+	if status == nil {
+		status = &machine.UpgradeStatus{}
+	}
+
+	return &machine.UpgradeStatusResponse{
+		Messages: []*machine.UpgradeStatus{
+			status,
+		},
+	}, nil
+}
+
 // Reset resets the node.
 //
 // nolint: dupl
@@ -277,7 +604,7 @@ func (s *Server) Reset(ctx context.Context, in *machine.ResetRequest) (reply *ma
 		if err := s.Controller.Run(runtime.SequenceReset, in); err != nil {
 			log.Println("reset failed:", err)
 
-			if err != runtime.ErrLocked {
+			if err != runtime.ErrLocked && !errors.Is(err, runtime.ErrCanceled) {
 				// NB: We stop the gRPC server since a failed sequence triggers a
 				// reboot.
 				s.server.GracefulStop()
@@ -308,7 +635,7 @@ func (s *Server) Recover(ctx context.Context, in *machine.RecoverRequest) (reply
 		if err := s.Controller.Run(runtime.SequenceRecover, in); err != nil {
 			log.Println("recover failed:", err)
 
-			if err != runtime.ErrLocked {
+			if err != runtime.ErrLocked && !errors.Is(err, runtime.ErrCanceled) {
 				// NB: We stop the gRPC server since a failed sequence triggers a
 				// reboot.
 				s.server.GracefulStop()
@@ -325,6 +652,98 @@ func (s *Server) Recover(ctx context.Context, in *machine.RecoverRequest) (reply
 	return reply, nil
 }
 
+// InterruptedSequence reports the sequence interrupted before the last boot and
+// optionally resumes, rolls it back or discards it.
+func (s *Server) InterruptedSequence(ctx context.Context, in *machine.InterruptedSequenceRequest) (reply *machine.InterruptedSequenceResponse, err error) {
+	checkpoint := s.Controller.InterruptedSequence()
+
+	if in.GetAction() != machine.InterruptedSequenceRequest_STATUS {
+		log.Printf("interrupted sequence %s request received", in.GetAction())
+
+		if err = s.Controller.ResolveInterruptedSequence(in.GetAction()); err != nil {
+			return nil, err
+		}
+	}
+
+	reply = &machine.InterruptedSequenceResponse{
+		Messages: []*machine.InterruptedSequence{
+			{
+				Checkpoint: checkpoint,
+			},
+		},
+	}
+
+	return reply, nil
+}
+
+// Sequences implements the machine.MachineServer interface.
+func (s *Server) Sequences(ctx context.Context, in *empty.Empty) (reply *machine.SequencesResponse, err error) {
+	reply = &machine.SequencesResponse{
+		Messages: []*machine.Sequences{
+			s.Controller.Sequences(),
+		},
+	}
+
+	return reply, nil
+}
+
+// SequenceCancel implements the machine.MachineServer interface.
+func (s *Server) SequenceCancel(ctx context.Context, in *machine.SequenceCancelRequest) (reply *machine.SequenceCancelResponse, err error) {
+	log.Printf("cancel %s sequence request received", in.GetSequence())
+
+	seq, err := runtime.ParseSequence(in.GetSequence())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.Controller.CancelSequence(seq); err != nil {
+		return nil, err
+	}
+
+	reply = &machine.SequenceCancelResponse{
+		Messages: []*machine.SequenceCancel{
+			{},
+		},
+	}
+
+	return reply, nil
+}
+
+// SequenceQueue implements the machine.MachineServer interface.
+func (s *Server) SequenceQueue(ctx context.Context, in *machine.SequenceQueueRequest) (reply *machine.SequenceQueueResponse, err error) {
+	log.Printf("queue %s sequence request received", in.GetSequence())
+
+	seq, err := runtime.ParseSequence(in.GetSequence())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.Controller.QueueSequence(seq); err != nil {
+		return nil, err
+	}
+
+	reply = &machine.SequenceQueueResponse{
+		Messages: []*machine.SequenceQueue{
+			{},
+		},
+	}
+
+	return reply, nil
+}
+
+// ServiceGraph returns dependency graph of the registered services.
+func (s *Server) ServiceGraph(ctx context.Context, in *empty.Empty) (result *machine.ServiceGraphResponse, err error) {
+	result = &machine.ServiceGraphResponse{
+		Messages: []*machine.ServiceGraph{
+			{
+				Nodes: system.Services(s.Controller.Runtime()).Graph(),
+			},
+		},
+	}
+
+	return result, nil
+}
+
 // ServiceList returns list of the registered services and their status.
 func (s *Server) ServiceList(ctx context.Context, in *empty.Empty) (result *machine.ServiceListResponse, err error) {
 	services := system.Services(s.Controller.Runtime()).List()
@@ -570,6 +989,176 @@ func (s *Server) Mounts(ctx context.Context, in *empty.Empty) (reply *machine.Mo
 	return reply, multiErr.ErrorOrNil()
 }
 
+// KernelArgs implements the machine.MachineServer interface.
+func (s *Server) KernelArgs(ctx context.Context, in *empty.Empty) (*machine.KernelArgsResponse, error) {
+	g, err := s.bootloader()
+	if err != nil {
+		return nil, err
+	}
+
+	label, args, err := g.KernelArgs()
+	if err != nil {
+		return nil, err
+	}
+
+	return kernelArgsResponse(label, args), nil
+}
+
+// KernelArgsUpdate implements the machine.MachineServer interface.
+//
+// The kernel args of the label booted by default are updated, so that the
+// changes take effect on the next boot. The updates are recorded in META, and
+// the installer applies them to the kernel args of the upgraded label.
+func (s *Server) KernelArgsUpdate(ctx context.Context, in *machine.KernelArgsUpdateRequest) (*machine.KernelArgsResponse, error) {
+	g, err := s.bootloader()
+	if err != nil {
+		return nil, err
+	}
+
+	label, args, err := g.KernelArgs()
+	if err != nil {
+		return nil, err
+	}
+
+	args = kernel.UpdateArgs(args, in.GetSet(), in.GetRemove())
+
+	if err = kernel.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	var prevSet, prevRemove []string
+
+	// META is written first, so that the boot label is never changed without
+	// the updates being recorded
+	err = meta.Update(func(values meta.Values) error {
+		prevSet, prevRemove = values.KernelArgs()
+
+		values.SetKernelArgs(kernel.MergeUpdates(prevSet, prevRemove, in.GetSet(), in.GetRemove()))
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = g.SetKernelArgs(label, args); err != nil {
+		rollbackErr := meta.Update(func(values meta.Values) error {
+			values.SetKernelArgs(prevSet, prevRemove)
+
+			return nil
+		})
+		if rollbackErr != nil {
+			log.Printf("failed to roll back kernel args updates in META: %s", rollbackErr)
+		}
+
+		return nil, err
+	}
+
+	return kernelArgsResponse(label, args), nil
+}
+
+func (s *Server) bootloader() (*grub.Grub, error) {
+	if s.Controller.Runtime().State().Platform().Mode() == runtime.ModeContainer {
+		return nil, fmt.Errorf("kernel args are not supported in container mode")
+	}
+
+	return &grub.Grub{
+		BootDisk: runtime.SystemDisk(s.Controller.Runtime()),
+	}, nil
+}
+
+func kernelArgsResponse(label, args string) *machine.KernelArgsResponse {
+	return &machine.KernelArgsResponse{
+		Messages: []*machine.KernelArgs{
+			{
+				Label: label,
+				Args:  strings.Fields(args),
+			},
+		},
+	}
+}
+
+// Disks implements the machine.MachineServer interface.
+func (s *Server) Disks(ctx context.Context, in *empty.Empty) (reply *machine.DisksResponse, err error) {
+	var userDisks []string
+
+	if s.Controller.Runtime().Config() != nil {
+		for _, d := range s.Controller.Runtime().Config().Machine().Disks() {
+			userDisks = append(userDisks, d.Device())
+		}
+	}
+
+	devices, err := inventory.List(userDisks...)
+	if err != nil {
+		return nil, err
+	}
+
+	disks := &machine.Disks{
+		Devices: make([]*machine.BlockDevice, 0, len(devices)),
+	}
+
+	for _, d := range devices {
+		device := &machine.BlockDevice{
+			DeviceName:     d.DeviceName,
+			Size:           d.Size,
+			Model:          d.Model,
+			Serial:         d.Serial,
+			Wwn:            d.WWN,
+			Type:           d.Type.String(),
+			BusPath:        d.BusPath,
+			PartitionTable: d.PartitionTable,
+			Filesystem:     blockDeviceFilesystem(d.FileSystem),
+		}
+
+		if d.Error != nil {
+			device.Error = d.Error.Error()
+		}
+
+		switch d.Owner {
+		case inventory.OwnerSystem:
+			device.Owner = machine.BlockDevice_SYSTEM
+		case inventory.OwnerUser:
+			device.Owner = machine.BlockDevice_USER
+		case inventory.OwnerNone:
+		}
+
+		for _, p := range d.Partitions {
+			device.Partitions = append(device.Partitions, &machine.BlockDevicePartition{
+				DeviceName: p.DeviceName,
+				Number:     p.Number,
+				Label:      p.Label,
+				TypeGuid:   p.TypeGUID,
+				Uuid:       p.UUID,
+				Size:       p.Size,
+				Filesystem: blockDeviceFilesystem(p.FileSystem),
+				Owned:      p.Owned,
+				MbrType:    uint32(p.MBRType),
+			})
+		}
+
+		disks.Devices = append(disks.Devices, device)
+	}
+
+	reply = &machine.DisksResponse{
+		Messages: []*machine.Disks{
+			disks,
+		},
+	}
+
+	return reply, nil
+}
+
+func blockDeviceFilesystem(fs *inventory.FileSystem) *machine.BlockDeviceFilesystem {
+	if fs == nil {
+		return nil
+	}
+
+	return &machine.BlockDeviceFilesystem{
+		Type:  fs.Type,
+		Label: fs.Label,
+	}
+}
+
 // Version implements the machine.MachineServer interface.
 func (s *Server) Version(ctx context.Context, in *empty.Empty) (reply *machine.VersionResponse, err error) {
 	var platform *machine.PlatformInfo
@@ -592,10 +1181,35 @@ func (s *Server) Version(ctx context.Context, in *empty.Empty) (reply *machine.V
 }
 
 // Kubeconfig implements the machine.MachineServer interface.
-func (s *Server) Kubeconfig(empty *empty.Empty, obj machine.MachineService_KubeconfigServer) error {
+//
+// The admin kubeconfig is generated unless the username is set in the request.
+func (s *Server) Kubeconfig(in *machine.KubeconfigRequest, obj machine.MachineService_KubeconfigServer) error {
+	var opts []kubeconfig.Option
+
+	if in.GetUsername() != "" {
+		opts = append(opts, kubeconfig.WithUsername(in.GetUsername()))
+	}
+
+	if len(in.GetGroups()) > 0 {
+		opts = append(opts, kubeconfig.WithGroups(in.GetGroups()...))
+	}
+
+	if in.GetTtl() != nil {
+		ttl, err := ptypes.Duration(in.GetTtl())
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		opts = append(opts, kubeconfig.WithTTL(ttl))
+	}
+
+	if in.GetUsername() != "" || in.GetTtl() != nil {
+		log.Printf("generating kubeconfig for %q (groups %v, TTL %s)", in.GetUsername(), in.GetGroups(), in.GetTtl().AsDuration())
+	}
+
 	var b bytes.Buffer
 
-	if err := kubeconfig.GenerateAdmin(s.Controller.Runtime().Config().Cluster(), &b); err != nil {
+	if err := kubeconfig.Generate(s.Controller.Runtime().Config().Cluster(), &b, opts...); err != nil {
 		return err
 	}
 
@@ -822,6 +1436,31 @@ func pullAndValidateInstallerImage(ctx context.Context, reg config.Registries, r
 		return err
 	}
 
+	return validateInstallerImage(containerdctx, client, img)
+}
+
+// validateImportedInstallerImage validates the installer image imported with
+// ImageImportStream.
+func validateImportedInstallerImage(ctx context.Context, ref string) error {
+	containerdctx := namespaces.WithNamespace(ctx, constants.SystemContainerdNamespace)
+
+	client, err := containerd.New(constants.SystemContainerdAddress)
+	if err != nil {
+		return err
+	}
+
+	// nolint: errcheck
+	defer client.Close()
+
+	img, err := client.GetImage(containerdctx, ref)
+	if err != nil {
+		return err
+	}
+
+	return validateInstallerImage(containerdctx, client, img)
+}
+
+func validateInstallerImage(containerdctx context.Context, client *containerd.Client, img containerd.Image) error {
 	// Launch the container with a known help command for a simple check to make sure the image is valid
 	args := []string{
 		"/bin/installer",
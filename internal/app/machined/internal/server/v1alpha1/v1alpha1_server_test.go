@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint: testpackage
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/internal/pkg/meta"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+func TestStagedUpgradeConflict(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		status        *machine.UpgradeStatus
+		trial         string
+		expectedError string
+	}{
+		{
+			name: "none",
+		},
+		{
+			name: "failed",
+			status: &machine.UpgradeStatus{
+				Stage: machine.UpgradeStatus_FAILED,
+				Image: "ghcr.io/talos-systems/installer:v0.7.0",
+			},
+		},
+		{
+			name: "staging",
+			status: &machine.UpgradeStatus{
+				Stage: machine.UpgradeStatus_STAGING,
+				Image: "ghcr.io/talos-systems/installer:v0.7.0",
+			},
+			expectedError: "upgrade to \"ghcr.io/talos-systems/installer:v0.7.0\" is already being staged",
+		},
+		{
+			name: "staged",
+			status: &machine.UpgradeStatus{
+				Stage: machine.UpgradeStatus_STAGED,
+				Image: "ghcr.io/talos-systems/installer:v0.7.0",
+				Label: "B",
+			},
+			trial:         "B",
+			expectedError: "upgrade to \"ghcr.io/talos-systems/installer:v0.7.0\" is staged, it should be committed or discarded first",
+		},
+		{
+			name:          "trial",
+			trial:         "B",
+			expectedError: "upgrade to label \"B\" is staged, it should be committed or discarded first",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			err := stagedUpgradeConflict(tt.status, tt.trial)
+
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestCheckMetaKey(t *testing.T) {
+	assert.NoError(t, checkMetaKey("rack"))
+
+	for _, key := range []string{meta.KernelArgsKey, meta.KernelArgsRemoveKey, "talos/foo"} {
+		err := checkMetaKey(key)
+
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
@@ -152,6 +152,10 @@ func main() {
 		handle(err)
 	}
 
+	// Report the sequence interrupted before this boot (if any), it can be
+	// resolved via the machine API.
+	c.DetectInterruptedSequence()
+
 	// Start the machine API.
 	system.Services(c.Runtime()).LoadAndStart(&services.Machined{Controller: c})
 
@@ -166,8 +170,8 @@ func main() {
 			for event := range events {
 				if msg, ok := event.Payload.(*machine.SequenceEvent); ok {
 					if msg.Error != nil {
-						if msg.Error.GetCode() == common.Code_LOCKED {
-							// ignore sequence lock errors, they're not fatal
+						if msg.Error.GetCode() == common.Code_LOCKED || msg.Error.GetCode() == common.Code_CANCELED {
+							// ignore sequence lock and cancel errors, they're not fatal
 							continue
 						}
 
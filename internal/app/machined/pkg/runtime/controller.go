@@ -7,6 +7,8 @@ package runtime
 import (
 	"context"
 	"log"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 )
 
 // TaskSetupFunc defines the function that a task will execute for a specific runtime
@@ -51,4 +53,15 @@ type Controller interface {
 	Runtime() Runtime
 	Sequencer() Sequencer
 	Run(Sequence, interface{}, ...ControllerOption) error
+	// InterruptedSequence returns the checkpoint of the sequence which was
+	// interrupted before the last boot, or nil.
+	InterruptedSequence() *machine.SequenceCheckpoint
+	// ResolveInterruptedSequence resumes, rolls back or discards the interrupted sequence.
+	ResolveInterruptedSequence(machine.InterruptedSequenceRequest_Action) error
+	// Sequences returns the progress of the running sequences and the list of queued sequences.
+	Sequences() *machine.Sequences
+	// CancelSequence requests the running sequence to stop.
+	CancelSequence(Sequence) error
+	// QueueSequence runs the sequence once the running sequence finishes.
+	QueueSequence(Sequence) error
 }
@@ -11,6 +11,9 @@ var (
 	// another sequence.
 	ErrLocked = errors.New("locked")
 
+	// ErrCanceled indicates that the sequence was canceled before it completed.
+	ErrCanceled = errors.New("canceled")
+
 	// ErrReboot indicates that a task is requesting a reboot.
 	ErrReboot = errors.New("reboot")
 
@@ -20,4 +23,8 @@ var (
 
 	// ErrUndefinedRuntime indicates that the sequencer's runtime is not defined.
 	ErrUndefinedRuntime = errors.New("undefined runtime")
+
+	// ErrNoInterruptedSequence indicates that there is no interrupted sequence
+	// to act upon.
+	ErrNoInterruptedSequence = errors.New("no interrupted sequence")
 )
@@ -7,6 +7,7 @@ package runtime
 import (
 	"github.com/talos-systems/go-blockdevice/blockdevice/probe"
 
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/config"
 )
 
@@ -28,6 +29,21 @@ type MachineState interface {
 	Disk() *probe.ProbedBlockDevice
 	Close() error
 	Installed() bool
+	StagedUpgrade() *machine.UpgradeStatus
+	SetStagedUpgrade(*machine.UpgradeStatus)
+}
+
+// SystemDisk returns the device name of the installed system disk, or an
+// empty string if the system disk is not found.
+//
+// With the empty disk name the bootloader looks up the boot partition by its
+// label on any disk.
+func SystemDisk(r Runtime) string {
+	if dev := r.State().Machine().Disk(); dev != nil {
+		return dev.BlockDevice.Device().Name()
+	}
+
+	return ""
 }
 
 // ClusterState defines the cluster state.
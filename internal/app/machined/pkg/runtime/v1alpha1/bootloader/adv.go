@@ -5,7 +5,9 @@
 package bootloader
 
 import (
+	"crypto/rand"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"os"
@@ -44,6 +46,12 @@ const (
 	AdvReserved3
 	// AdvUpgrade is the upgrade tag.
 	AdvUpgrade
+	// AdvSequence is the sequence checkpoint tag.
+	AdvSequence
+	// AdvNodeSecret is the node-unique secret tag.
+	AdvNodeSecret
+	// AdvUpgradeTrial is the tag of the label booted on trial after an upgrade.
+	AdvUpgradeTrial
 )
 
 // Meta represents the meta reader.
@@ -75,6 +83,38 @@ func NewMeta() (meta *Meta, err error) {
 	}, nil
 }
 
+// NodeSecret reads the node-unique secret from META, the secret is generated
+// on the first use.
+func NodeSecret() ([]byte, error) {
+	meta, err := NewMeta()
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer meta.Close()
+
+	if val, ok := meta.ReadTag(AdvNodeSecret); ok {
+		return hex.DecodeString(val)
+	}
+
+	secret := make([]byte, 32)
+
+	if _, err = rand.Read(secret); err != nil {
+		return nil, err
+	}
+
+	if ok := meta.SetTag(AdvNodeSecret, hex.EncodeToString(secret)); !ok {
+		return nil, fmt.Errorf("failed to set node secret tag")
+	}
+
+	if _, err = meta.Write(); err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
 func (m *Meta) Read(b []byte) (int, error) {
 	return m.File.Read(b)
 }
@@ -108,6 +148,7 @@ func (m *Meta) Revert() (err error) {
 
 	if label == "" {
 		m.DeleteTag(AdvUpgrade)
+		m.DeleteTag(AdvUpgradeTrial)
 
 		if _, err = m.Write(); err != nil {
 			return err
@@ -122,7 +163,12 @@ func (m *Meta) Revert() (err error) {
 		return err
 	}
 
+	if err = g.ResetTrial(); err != nil {
+		return err
+	}
+
 	m.DeleteTag(AdvUpgrade)
+	m.DeleteTag(AdvUpgradeTrial)
 
 	if _, err = m.Write(); err != nil {
 		return err
@@ -131,6 +177,25 @@ func (m *Meta) Revert() (err error) {
 	return nil
 }
 
+// StartTrial marks the label as booted on trial, the fallback label is
+// booted again unless the trial is confirmed. The boot attempts are counted
+// by the bootloader.
+func (a ADV) StartTrial(fallback, label string) (ok bool) {
+	a.DeleteTag(AdvUpgrade)
+	a.DeleteTag(AdvUpgradeTrial)
+
+	return a.SetTag(AdvUpgrade, fallback) && a.SetTag(AdvUpgradeTrial, label)
+}
+
+// ConfirmTrial confirms the label booted on trial, so that there is no
+// fallback anymore. It returns false if there was nothing to confirm.
+func (a ADV) ConfirmTrial() (ok bool) {
+	ok = a.DeleteTag(AdvUpgrade)
+	ok = a.DeleteTag(AdvUpgradeTrial) || ok
+
+	return ok
+}
+
 // NewADV returns the Auxiliary Data Vector.
 func NewADV(r io.ReadSeeker) (adv ADV, err error) {
 	_, err = r.Seek(-2*AdvSize, io.SeekEnd)
@@ -204,13 +269,16 @@ func (a ADV) SetTag(t uint8, val string) (ok bool) {
 			continue
 		}
 
-		length := uint8(len(b))
+		if i+2+len(b) > AdvSize-4 {
+			// Not enough space left for the tag.
+			break
+		}
 
 		a[i] = t
 
-		a[i+1] = length
+		a[i+1] = uint8(len(b))
 
-		copy(a[i+2:uint8(i+2)+length], b)
+		copy(a[i+2:i+2+len(b)], b)
 
 		ok = true
 
@@ -248,24 +316,20 @@ func (a ADV) DeleteTag(t uint8) (ok bool) {
 		// of the tag data.
 		start := i + 2 + size
 
-		end := a[AdvSize-4]
+		end := AdvSize - 4
 
 		data := make([]byte, len(a[start:end]))
 
 		copy(data, a[start:end])
 
-		// The total size we want to zero out is the length of all the remaining
-		// data we saved above.
-		length := 2 + len(data)
+		// Shift the data.
+		copy(a[i:], data)
 
-		// Zero each element to the right.
-		for j := i; j < length; j++ {
+		// Zero each element to the right of the shifted data.
+		for j := i + len(data); j < end; j++ {
 			a[j] = 0
 		}
 
-		// Shift the data.
-		copy(a[i:], data)
-
 		ok = true
 
 		break
@@ -321,3 +321,71 @@ func TestADV_tail(t *testing.T) {
 		})
 	}
 }
+
+func TestADV_MultipleTags(t *testing.T) {
+	a := make(ADV, 2*AdvSize)
+
+	long := string(bytes.Repeat([]byte("a"), 250))
+
+	if ok := a.SetTag(AdvUpgrade, long); !ok {
+		t.Fatalf("SetTag() failed")
+	}
+
+	if ok := a.SetTag(AdvSequence, "sequence"); !ok {
+		t.Fatalf("SetTag() failed")
+	}
+
+	if ok := a.SetTag(AdvMenusave, long); ok {
+		t.Errorf("SetTag() should fail when there is not enough space")
+	}
+
+	if ok := a.DeleteTag(AdvUpgrade); !ok {
+		t.Fatalf("DeleteTag() failed")
+	}
+
+	if val, ok := a.ReadTag(AdvSequence); !ok || val != "sequence" {
+		t.Errorf("ReadTag() = %q, %v, want %q, true", val, ok, "sequence")
+	}
+
+	if _, ok := a.ReadTag(AdvUpgrade); ok {
+		t.Errorf("ReadTag() should not find deleted tag")
+	}
+
+	if ok := a.SetTag(AdvMenusave, long); !ok {
+		t.Errorf("SetTag() failed after freeing space")
+	}
+}
+
+func TestADV_Trial(t *testing.T) {
+	a := make(ADV, 2*AdvSize)
+
+	if ok := a.StartTrial("A", "B"); !ok {
+		t.Fatalf("StartTrial() failed")
+	}
+
+	if ok := a.StartTrial("B", "A"); !ok {
+		t.Fatalf("StartTrial() failed")
+	}
+
+	if val, ok := a.ReadTag(AdvUpgrade); !ok || val != "B" {
+		t.Errorf("ReadTag() = %q, %v, want %q, true", val, ok, "B")
+	}
+
+	if val, ok := a.ReadTag(AdvUpgradeTrial); !ok || val != "A" {
+		t.Errorf("ReadTag() = %q, %v, want %q, true", val, ok, "A")
+	}
+
+	if ok := a.ConfirmTrial(); !ok {
+		t.Fatalf("ConfirmTrial() failed")
+	}
+
+	for _, tag := range []uint8{AdvUpgrade, AdvUpgradeTrial} {
+		if _, ok := a.ReadTag(tag); ok {
+			t.Errorf("ReadTag(%d) should not find confirmed trial tag", tag)
+		}
+	}
+
+	if ok := a.ConfirmTrial(); ok {
+		t.Errorf("ConfirmTrial() should fail without a trial")
+	}
+}
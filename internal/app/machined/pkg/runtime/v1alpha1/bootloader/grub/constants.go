@@ -16,6 +16,9 @@ const (
 	// GrubConfig is the path to the grub config.
 	GrubConfig = constants.BootMountPoint + "/grub/grub.cfg"
 
+	// GrubEnv is the path to the grub environment block.
+	GrubEnv = constants.BootMountPoint + "/grub/grubenv"
+
 	// GrubDeviceMap is the path to the grub device map.
 	GrubDeviceMap = constants.BootMountPoint + "/grub/device.map"
 )
@@ -18,6 +18,8 @@ import (
 	"strings"
 	"text/template"
 
+	"golang.org/x/sys/unix"
+
 	"github.com/talos-systems/go-blockdevice/blockdevice/probe"
 	"github.com/talos-systems/go-blockdevice/blockdevice/util"
 
@@ -29,7 +31,21 @@ import (
 type Cfg struct {
 	Default  string
 	Fallback string
-	Labels   []*Label
+	// BootAttempts is the number of the attempts to boot the default label
+	// on trial, grub boots the fallback label once they are exhausted.
+	BootAttempts int
+	Labels       []*Label
+}
+
+// Attempts returns the values of the boot attempts counter.
+func (c *Cfg) Attempts() []int {
+	attempts := make([]int, c.BootAttempts)
+
+	for i := range attempts {
+		attempts[i] = i + 1
+	}
+
+	return attempts
 }
 
 // Label reprsents a label in the cfg file.
@@ -45,6 +61,21 @@ const grubCfgTpl = `set default="{{ .Default }}"
 set fallback="{{ . }}"
 {{- end }}
 set timeout=0
+{{- if and .Fallback .BootAttempts }}
+
+load_env
+if [ "${upgrade_trial}" = "${default}" ]; then
+{{- range $i, $n := .Attempts }}
+  {{ if $i }}elif{{ else }}if{{ end }} [ "${boot_attempts}" = "{{ $i }}" ]; then
+    set boot_attempts="{{ $n }}"
+{{- end }}
+  else
+    set boot_attempts="failed"
+    set default="${fallback}"
+  fi
+  save_env boot_attempts
+fi
+{{- end }}
 
 terminal_input console
 terminal_output console
@@ -115,6 +146,16 @@ func (g *Grub) Install(fallback string, config interface{}, sequence runtime.Seq
 		return err
 	}
 
+	if grubcfg.Fallback != "" && grubcfg.BootAttempts > 0 {
+		err = g.StartTrial(grubcfg.Default)
+	} else {
+		err = g.ResetTrial()
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to write grub environment block: %w", err)
+	}
+
 	dev, err := probe.DevForFileSystemLabel(g.BootDisk, constants.BootPartitionLabel)
 	if err != nil {
 		return fmt.Errorf("failed to probe boot partition: %w", err)
@@ -171,9 +212,154 @@ func (g *Grub) Install(fallback string, config interface{}, sequence runtime.Seq
 	return nil
 }
 
-// Default implements the bootloader interface.
+// Default implements the bootloader interface. It makes the label the
+// default one, and the previous default becomes the fallback.
 func (g *Grub) Default(label string) error {
-	return nil
+	return g.withBootMounted(func() error {
+		b, err := ioutil.ReadFile(GrubConfig)
+		if err != nil {
+			return err
+		}
+
+		if b, err = setDefault(b, label); err != nil {
+			return err
+		}
+
+		log.Printf("setting default boot label to %q", label)
+
+		return ioutil.WriteFile(GrubConfig, b, 0o600)
+	})
+}
+
+var (
+	defaultRe  = regexp.MustCompile(`(?m)^set default="(.*)"$`)
+	fallbackRe = regexp.MustCompile(`(?m)^set fallback="(.*)"$`)
+)
+
+func setDefault(cfg []byte, label string) ([]byte, error) {
+	matches := defaultRe.FindSubmatch(cfg)
+	if matches == nil {
+		return nil, fmt.Errorf("failed to find default")
+	}
+
+	previous := string(matches[1])
+
+	if previous == label {
+		return cfg, nil
+	}
+
+	if !bytes.Contains(cfg, []byte(fmt.Sprintf("menuentry %q", label))) {
+		return nil, fmt.Errorf("unknown grub menuentry: %q", label)
+	}
+
+	cfg = defaultRe.ReplaceAllLiteral(cfg, []byte(fmt.Sprintf("set default=%q", label)))
+	cfg = fallbackRe.ReplaceAllLiteral(cfg, []byte(fmt.Sprintf("set fallback=%q", previous)))
+
+	return cfg, nil
+}
+
+// KernelArgs returns the label booted by default and its kernel args.
+func (g *Grub) KernelArgs() (label, args string, err error) {
+	err = g.withBootMounted(func() error {
+		b, e := ioutil.ReadFile(GrubConfig)
+		if e != nil {
+			return e
+		}
+
+		matches := defaultRe.FindSubmatch(b)
+		if matches == nil {
+			return fmt.Errorf("failed to find default")
+		}
+
+		label = string(matches[1])
+
+		args, e = kernelArgs(b, label)
+
+		return e
+	})
+
+	return label, args, err
+}
+
+// SetKernelArgs replaces the kernel args of the label.
+func (g *Grub) SetKernelArgs(label, args string) error {
+	return g.withBootMounted(func() error {
+		b, err := ioutil.ReadFile(GrubConfig)
+		if err != nil {
+			return err
+		}
+
+		if b, err = setKernelArgs(b, label, args); err != nil {
+			return err
+		}
+
+		log.Printf("setting kernel args of boot label %q to %q", label, args)
+
+		return ioutil.WriteFile(GrubConfig, b, 0o600)
+	})
+}
+
+// linuxRe matches the linux command of the menuentry: the kernel and its args.
+func linuxRe(label string) *regexp.Regexp {
+	return regexp.MustCompile(`(?m)(menuentry "` + regexp.QuoteMeta(label) + `" \{\n\s*linux\s+\S+)(.*)$`)
+}
+
+func kernelArgs(cfg []byte, label string) (string, error) {
+	matches := linuxRe(label).FindSubmatch(cfg)
+	if matches == nil {
+		return "", fmt.Errorf("unknown grub menuentry: %q", label)
+	}
+
+	return strings.TrimSpace(string(matches[2])), nil
+}
+
+func setKernelArgs(cfg []byte, label, args string) ([]byte, error) {
+	re := linuxRe(label)
+
+	if !re.Match(cfg) {
+		return nil, fmt.Errorf("unknown grub menuentry: %q", label)
+	}
+
+	return re.ReplaceAll(cfg, []byte("${1} "+strings.ReplaceAll(args, "$", "$$"))), nil
+}
+
+// withBootMounted runs f with the boot partition mounted, the partition is
+// mounted only for the duration of f if it is not mounted yet.
+func (g *Grub) withBootMounted(f func() error) (err error) {
+	if _, err = os.Stat(GrubConfig); err == nil {
+		return f()
+	}
+
+	var dev *probe.ProbedBlockDevice
+
+	if g.BootDisk != "" {
+		dev, err = probe.DevForFileSystemLabel(g.BootDisk, constants.BootPartitionLabel)
+	} else {
+		dev, err = probe.GetDevWithFileSystemLabel(constants.BootPartitionLabel)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to probe boot partition: %w", err)
+	}
+
+	// nolint: errcheck
+	defer dev.Close()
+
+	if err = os.MkdirAll(constants.BootMountPoint, os.ModeDir); err != nil {
+		return err
+	}
+
+	if err = unix.Mount(dev.Path, constants.BootMountPoint, dev.SuperBlock.Type(), 0, ""); err != nil {
+		return fmt.Errorf("failed to mount boot partition: %w", err)
+	}
+
+	defer func() {
+		if e := unix.Unmount(constants.BootMountPoint, 0); e != nil && err == nil {
+			err = fmt.Errorf("failed to unmount boot partition: %w", e)
+		}
+	}()
+
+	return f()
 }
 
 func writeCfg(path string, grubcfg *Cfg) (err error) {
@@ -0,0 +1,158 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint: testpackage
+package grub
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetDefault(t *testing.T) {
+	var buf bytes.Buffer
+
+	cfg := &Cfg{
+		Default:  BootB,
+		Fallback: BootA,
+		Labels: []*Label{
+			{Root: BootB, Kernel: "/B/vmlinuz", Initrd: "/B/initramfs.xz"},
+			{Root: BootA, Kernel: "/A/vmlinuz", Initrd: "/A/initramfs.xz"},
+		},
+	}
+
+	require.NoError(t, template.Must(template.New("grub").Parse(grubCfgTpl)).Execute(&buf, cfg))
+
+	b, err := setDefault(buf.Bytes(), BootA)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(b), "set default=\"A\"\nset fallback=\"B\"\n")
+	assert.Contains(t, string(b), "menuentry \"B\" {\n  linux /B/vmlinuz")
+
+	unchanged, err := setDefault(b, BootA)
+	require.NoError(t, err)
+	assert.Equal(t, b, unchanged)
+
+	_, err = setDefault(b, "C")
+	assert.EqualError(t, err, `unknown grub menuentry: "C"`)
+}
+
+func TestKernelArgs(t *testing.T) {
+	var buf bytes.Buffer
+
+	cfg := &Cfg{
+		Default:  BootB,
+		Fallback: BootA,
+		Labels: []*Label{
+			{Root: BootB, Kernel: "/B/vmlinuz", Initrd: "/B/initramfs.xz", Append: "talos.platform=metal console=tty0"},
+			{Root: BootA, Kernel: "/A/vmlinuz", Initrd: "/A/initramfs.xz", Append: "talos.platform=metal"},
+		},
+	}
+
+	require.NoError(t, template.Must(template.New("grub").Parse(grubCfgTpl)).Execute(&buf, cfg))
+
+	args, err := kernelArgs(buf.Bytes(), BootB)
+	require.NoError(t, err)
+	assert.Equal(t, "talos.platform=metal console=tty0", args)
+
+	b, err := setKernelArgs(buf.Bytes(), BootB, "talos.platform=metal console=ttyS0,115200n8")
+	require.NoError(t, err)
+
+	args, err = kernelArgs(b, BootB)
+	require.NoError(t, err)
+	assert.Equal(t, "talos.platform=metal console=ttyS0,115200n8", args)
+
+	assert.Contains(t, string(b), "menuentry \"B\" {\n  linux /B/vmlinuz talos.platform=metal console=ttyS0,115200n8\n  initrd /B/initramfs.xz\n}")
+
+	args, err = kernelArgs(b, BootA)
+	require.NoError(t, err)
+	assert.Equal(t, "talos.platform=metal", args)
+
+	_, err = setKernelArgs(b, "C", "")
+	assert.EqualError(t, err, `unknown grub menuentry: "C"`)
+}
+
+func TestTrial(t *testing.T) {
+	var buf bytes.Buffer
+
+	cfg := &Cfg{
+		Default:      BootB,
+		Fallback:     BootA,
+		BootAttempts: 2,
+		Labels: []*Label{
+			{Root: BootB, Kernel: "/B/vmlinuz", Initrd: "/B/initramfs.xz"},
+			{Root: BootA, Kernel: "/A/vmlinuz", Initrd: "/A/initramfs.xz"},
+		},
+	}
+
+	require.NoError(t, template.Must(template.New("grub").Parse(grubCfgTpl)).Execute(&buf, cfg))
+
+	assert.Contains(t, buf.String(), `set timeout=0
+
+load_env
+if [ "${upgrade_trial}" = "${default}" ]; then
+  if [ "${boot_attempts}" = "0" ]; then
+    set boot_attempts="1"
+  elif [ "${boot_attempts}" = "1" ]; then
+    set boot_attempts="2"
+  else
+    set boot_attempts="failed"
+    set default="${fallback}"
+  fi
+  save_env boot_attempts
+fi
+
+terminal_input console
+`)
+
+	// the trial doesn't change the default label
+	matches := defaultRe.FindAllSubmatch(buf.Bytes(), -1)
+	require.Len(t, matches, 1)
+	assert.Equal(t, BootB, string(matches[0][1]))
+
+	b, err := setDefault(buf.Bytes(), BootA)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "set default=\"A\"\nset fallback=\"B\"\n")
+
+	buf.Reset()
+
+	cfg.Fallback = ""
+
+	require.NoError(t, template.Must(template.New("grub").Parse(grubCfgTpl)).Execute(&buf, cfg))
+	assert.NotContains(t, buf.String(), "load_env")
+}
+
+func TestEnv(t *testing.T) {
+	dir, err := ioutil.TempDir("", "talos")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	path := filepath.Join(dir, "grubenv")
+
+	env, err := readEnv(path)
+	require.NoError(t, err)
+	assert.Empty(t, env)
+
+	require.NoError(t, writeEnv(path, map[string]string{envUpgradeTrial: BootB, envBootAttempts: "0"}))
+
+	b, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Len(t, b, envSize)
+	assert.True(t, bytes.HasPrefix(b, []byte("# GRUB Environment Block\nboot_attempts=0\nupgrade_trial=B\n###")))
+
+	env, err = readEnv(path)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{envUpgradeTrial: BootB, envBootAttempts: "0"}, env)
+
+	assert.Error(t, writeEnv(path, map[string]string{"foo": strings.Repeat("x", envSize)}))
+}
@@ -0,0 +1,147 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package grub
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+const (
+	// envSize is the size of the grub environment block.
+	envSize   = 1024
+	envHeader = "# GRUB Environment Block\n"
+
+	// envUpgradeTrial is the label booted on trial after an upgrade.
+	envUpgradeTrial = "upgrade_trial"
+	// envBootAttempts is the number of the trial label boot attempts, grub
+	// increments it on every boot of the trial label.
+	envBootAttempts = "boot_attempts"
+	// bootAttemptsFailed is the value grub sets once the attempts are
+	// exhausted and the fallback label is booted instead.
+	bootAttemptsFailed = "failed"
+)
+
+// StartTrial makes grub count the boot attempts of the label, grub boots the
+// fallback label once the attempts configured in grub.cfg are exhausted.
+func (g *Grub) StartTrial(label string) error {
+	return g.withBootMounted(func() error {
+		env, err := readEnv(GrubEnv)
+		if err != nil {
+			return err
+		}
+
+		env[envUpgradeTrial] = label
+		env[envBootAttempts] = "0"
+
+		return writeEnv(GrubEnv, env)
+	})
+}
+
+// Trial returns the label booted on trial, and whether grub gave up on it and
+// booted the fallback label.
+func (g *Grub) Trial() (label string, failed bool, err error) {
+	err = g.withBootMounted(func() error {
+		env, e := readEnv(GrubEnv)
+		if e != nil {
+			return e
+		}
+
+		label = env[envUpgradeTrial]
+		failed = label != "" && env[envBootAttempts] == bootAttemptsFailed
+
+		return nil
+	})
+
+	return label, failed, err
+}
+
+// ResetTrial stops counting the boot attempts.
+func (g *Grub) ResetTrial() error {
+	return g.withBootMounted(func() error {
+		env, err := readEnv(GrubEnv)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := env[envUpgradeTrial]; !ok {
+			return nil
+		}
+
+		delete(env, envUpgradeTrial)
+		delete(env, envBootAttempts)
+
+		log.Println("resetting boot attempts")
+
+		return writeEnv(GrubEnv, env)
+	})
+}
+
+// readEnv reads the grub environment block, a missing block is empty.
+func readEnv(path string) (map[string]string, error) {
+	env := map[string]string{}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return env, nil
+		}
+
+		return nil, err
+	}
+
+	if !bytes.HasPrefix(b, []byte(envHeader)) {
+		return nil, fmt.Errorf("invalid grub environment block: %s", path)
+	}
+
+	for _, line := range strings.Split(string(b[len(envHeader):]), "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+
+		env[kv[0]] = kv[1]
+	}
+
+	return env, nil
+}
+
+// writeEnv writes the grub environment block, the block is padded with '#'
+// to its fixed size.
+func writeEnv(path string, env map[string]string) error {
+	keys := make([]string, 0, len(env))
+
+	for k := range env {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+
+	buf.WriteString(envHeader)
+
+	for _, k := range keys {
+		fmt.Fprintf(&buf, "%s=%s\n", k, env[k])
+	}
+
+	if buf.Len() > envSize {
+		return fmt.Errorf("grub environment block is too large: %d bytes", buf.Len())
+	}
+
+	buf.Write(bytes.Repeat([]byte("#"), envSize-buf.Len()))
+
+	return ioutil.WriteFile(path, buf.Bytes(), 0o600)
+}
@@ -0,0 +1,122 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+// CheckpointStore persists the progress of a running sequence.
+type CheckpointStore interface {
+	Load() (*machine.SequenceCheckpoint, error)
+	Save(*machine.SequenceCheckpoint) error
+	Clear() error
+}
+
+// MetaCheckpointStore stores sequence checkpoints in the ADV of the META partition.
+type MetaCheckpointStore struct{}
+
+// Load implements the CheckpointStore interface.
+func (*MetaCheckpointStore) Load() (*machine.SequenceCheckpoint, error) {
+	meta, err := bootloader.NewMeta()
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer meta.Close()
+
+	val, ok := meta.ReadTag(bootloader.AdvSequence)
+	if !ok {
+		return nil, nil
+	}
+
+	checkpoint := &machine.SequenceCheckpoint{}
+
+	if err = proto.Unmarshal([]byte(val), checkpoint); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sequence checkpoint: %w", err)
+	}
+
+	return checkpoint, nil
+}
+
+// Save implements the CheckpointStore interface.
+func (*MetaCheckpointStore) Save(checkpoint *machine.SequenceCheckpoint) error {
+	b, err := proto.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf("failed to marshal sequence checkpoint: %w", err)
+	}
+
+	meta, err := bootloader.NewMeta()
+	if err != nil {
+		return err
+	}
+
+	// nolint: errcheck
+	defer meta.Close()
+
+	meta.DeleteTag(bootloader.AdvSequence)
+
+	if ok := meta.SetTag(bootloader.AdvSequence, string(b)); !ok {
+		return fmt.Errorf("failed to set sequence tag: %d bytes don't fit into ADV", len(b))
+	}
+
+	_, err = meta.Write()
+
+	return err
+}
+
+// Clear implements the CheckpointStore interface.
+func (*MetaCheckpointStore) Clear() error {
+	meta, err := bootloader.NewMeta()
+	if err != nil {
+		return err
+	}
+
+	// nolint: errcheck
+	defer meta.Close()
+
+	if ok := meta.DeleteTag(bootloader.AdvSequence); !ok {
+		return nil
+	}
+
+	_, err = meta.Write()
+
+	return err
+}
+
+// checkpointedSequences lists the sequences which persist their progress,
+// so that they can be resumed or rolled back if interrupted.
+var checkpointedSequences = map[runtime.Sequence]func() proto.Message{
+	runtime.SequenceUpgrade: func() proto.Message { return &machine.UpgradeRequest{} },
+	runtime.SequenceReset:   func() proto.Message { return &machine.ResetRequest{} },
+}
+
+// decodeCheckpoint returns the sequence and the request stored in the checkpoint.
+func decodeCheckpoint(checkpoint *machine.SequenceCheckpoint) (runtime.Sequence, proto.Message, error) {
+	seq, err := runtime.ParseSequence(checkpoint.GetSequence())
+	if err != nil {
+		return seq, nil, err
+	}
+
+	newRequest, ok := checkpointedSequences[seq]
+	if !ok {
+		return seq, nil, fmt.Errorf("sequence %q doesn't support checkpoints", seq)
+	}
+
+	in := newRequest()
+
+	if err = proto.Unmarshal(checkpoint.GetRequest(), in); err != nil {
+		return seq, nil, fmt.Errorf("failed to unmarshal %s sequence request: %w", seq, err)
+	}
+
+	return seq, in, nil
+}
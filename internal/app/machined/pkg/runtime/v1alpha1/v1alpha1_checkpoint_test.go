@@ -0,0 +1,146 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint: testpackage
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+type memoryCheckpointStore struct {
+	checkpoint *machine.SequenceCheckpoint
+	history    []string
+}
+
+func (store *memoryCheckpointStore) Load() (*machine.SequenceCheckpoint, error) {
+	return store.checkpoint, nil
+}
+
+func (store *memoryCheckpointStore) Save(checkpoint *machine.SequenceCheckpoint) error {
+	store.checkpoint = proto.Clone(checkpoint).(*machine.SequenceCheckpoint) //nolint: errcheck
+	store.history = append(store.history, checkpoint.PhaseName)
+
+	return nil
+}
+
+func (store *memoryCheckpointStore) Clear() error {
+	if store.checkpoint != nil {
+		store.history = append(store.history, "<clear>")
+	}
+
+	store.checkpoint = nil
+
+	return nil
+}
+
+func newCheckpointTestController(store CheckpointStore) *Controller {
+	return &Controller{
+		r:           NewRuntime(nil, nil, NewEvents(100, 10), logging.NewNullLoggingManager()),
+		s:           NewSequencer(),
+		checkpoints: store,
+	}
+}
+
+func TestControllerCheckpoints(t *testing.T) {
+	store := &memoryCheckpointStore{}
+	c := newCheckpointTestController(store)
+
+	phases := PhaseList{}.Append("drain").Append("upgrade").Append("reboot")
+
+	require.NoError(t, c.run(runtime.SequenceUpgrade, phases, &machine.UpgradeRequest{Image: "installer:v1"}))
+
+	assert.Equal(t, []string{"drain", "upgrade", "<clear>"}, store.history)
+	assert.Nil(t, store.checkpoint)
+
+	// sequences which don't support checkpoints are not recorded
+	store.history = nil
+
+	require.NoError(t, c.run(runtime.SequenceReboot, phases, nil))
+
+	assert.Empty(t, store.history)
+}
+
+func TestControllerInterruptedSequence(t *testing.T) {
+	request, err := proto.Marshal(&machine.UpgradeRequest{Image: "installer:v1", Preserve: true})
+	require.NoError(t, err)
+
+	store := &memoryCheckpointStore{
+		checkpoint: &machine.SequenceCheckpoint{
+			Sequence:    runtime.SequenceUpgrade.String(),
+			Phase:       2,
+			PhaseName:   "upgrade",
+			TotalPhases: 3,
+			Request:     request,
+		},
+	}
+	c := newCheckpointTestController(store)
+
+	assert.Equal(t, runtime.ErrNoInterruptedSequence, c.ResolveInterruptedSequence(machine.InterruptedSequenceRequest_DISCARD))
+
+	c.DetectInterruptedSequence()
+
+	checkpoint := c.InterruptedSequence()
+	require.NotNil(t, checkpoint)
+	assert.Equal(t, "upgrade", checkpoint.PhaseName)
+
+	seq, in, err := decodeCheckpoint(checkpoint)
+	require.NoError(t, err)
+	assert.Equal(t, runtime.SequenceUpgrade, seq)
+	assert.True(t, proto.Equal(&machine.UpgradeRequest{Image: "installer:v1", Preserve: true}, in))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	eventCh := make(chan runtime.Event, 1)
+
+	require.NoError(t, c.Runtime().Events().Watch(func(events <-chan runtime.Event) {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-events:
+				eventCh <- event
+
+				return
+			}
+		}
+	}, runtime.WithTailEvents(-1)))
+
+	event := <-eventCh
+	assert.True(t, proto.Equal(&machine.SequenceInterruptedEvent{Checkpoint: checkpoint}, event.Payload))
+
+	require.NoError(t, c.ResolveInterruptedSequence(machine.InterruptedSequenceRequest_STATUS))
+	assert.NotNil(t, c.InterruptedSequence())
+
+	require.NoError(t, c.ResolveInterruptedSequence(machine.InterruptedSequenceRequest_DISCARD))
+	assert.Nil(t, c.InterruptedSequence())
+	assert.Nil(t, store.checkpoint)
+}
+
+func TestControllerInterruptedSequenceRollback(t *testing.T) {
+	store := &memoryCheckpointStore{
+		checkpoint: &machine.SequenceCheckpoint{
+			Sequence:    runtime.SequenceReset.String(),
+			Phase:       1,
+			PhaseName:   "drain",
+			TotalPhases: 5,
+		},
+	}
+	c := newCheckpointTestController(store)
+
+	c.DetectInterruptedSequence()
+
+	assert.EqualError(t, c.ResolveInterruptedSequence(machine.InterruptedSequenceRequest_ROLLBACK), "rollback is not supported for reset sequence")
+	assert.NotNil(t, c.InterruptedSequence())
+}
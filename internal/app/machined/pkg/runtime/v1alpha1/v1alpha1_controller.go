@@ -6,6 +6,7 @@ package v1alpha1
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -14,15 +15,18 @@ import (
 	"os/signal"
 	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"syscall"
 	"time"
 
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/acpi"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/grub"
 	"github.com/talos-systems/talos/internal/pkg/kmsg"
 	"github.com/talos-systems/talos/pkg/machinery/api/common"
 	"github.com/talos-systems/talos/pkg/machinery/api/machine"
@@ -37,6 +41,15 @@ type Controller struct {
 	s *Sequencer
 
 	semaphore int32
+
+	// checkpoints is nil if sequence progress is not persisted.
+	checkpoints   CheckpointStore
+	interruptedMu sync.Mutex
+	interrupted   *machine.SequenceCheckpoint
+
+	progressMu sync.Mutex
+	running    []*sequenceProgress
+	queue      []runtime.Sequence
 }
 
 // NewController intializes and returns a controller.
@@ -73,6 +86,10 @@ func NewController(b []byte) (*Controller, error) {
 		s: NewSequencer(),
 	}
 
+	if s.Platform().Mode() != runtime.ModeContainer {
+		ctlr.checkpoints = &MetaCheckpointStore{}
+	}
+
 	return ctlr, nil
 }
 
@@ -97,7 +114,9 @@ func (c *Controller) Run(seq runtime.Sequence, data interface{}, setters ...runt
 	// and reset sequences.
 	switch seq { //nolint: exhaustive
 	case runtime.SequenceBootstrap, runtime.SequenceReset, runtime.SequenceRecover:
-		// Do not attempt to lock.
+		// Do not attempt to lock, but start the sequence queued while this one
+		// was running.
+		defer c.runQueue()
 	default:
 		if opts.Force {
 			break
@@ -116,7 +135,12 @@ func (c *Controller) Run(seq runtime.Sequence, data interface{}, setters ...runt
 			return runtime.ErrLocked
 		}
 
-		defer c.Unlock()
+		defer func() {
+			c.Unlock()
+
+			// start the sequence queued while this one was running
+			c.runQueue()
+		}()
 	}
 
 	phases, err := c.phases(seq, data)
@@ -125,6 +149,19 @@ func (c *Controller) Run(seq runtime.Sequence, data interface{}, setters ...runt
 	}
 
 	err = c.run(seq, phases, data)
+	if errors.Is(err, runtime.ErrCanceled) {
+		c.Runtime().Events().Publish(&machine.SequenceEvent{
+			Sequence: seq.String(),
+			Action:   machine.SequenceEvent_NOOP,
+			Error: &common.Error{
+				Code:    common.Code_CANCELED,
+				Message: fmt.Sprintf("sequence canceled: %s", err.Error()),
+			},
+		})
+
+		return err
+	}
+
 	if err != nil {
 		c.Runtime().Events().Publish(&machine.SequenceEvent{
 			Sequence: seq.String(),
@@ -151,6 +188,126 @@ func (c *Controller) Sequencer() runtime.Sequencer {
 	return c.s
 }
 
+// DetectInterruptedSequence checks for a checkpoint left by a sequence which
+// didn't complete (e.g. because of a power loss). If one is found, it is kept
+// until resolved with ResolveInterruptedSequence, and an event is published.
+func (c *Controller) DetectInterruptedSequence() {
+	if c.checkpoints == nil {
+		return
+	}
+
+	checkpoint, err := c.checkpoints.Load()
+	if err != nil {
+		log.Printf("failed to load sequence checkpoint: %v", err)
+
+		return
+	}
+
+	if checkpoint == nil {
+		return
+	}
+
+	log.Printf("%s sequence was interrupted in phase %s (%d/%d)", checkpoint.Sequence, checkpoint.PhaseName, checkpoint.Phase, checkpoint.TotalPhases)
+
+	c.interruptedMu.Lock()
+	c.interrupted = checkpoint
+	c.interruptedMu.Unlock()
+
+	c.Runtime().Events().Publish(&machine.SequenceInterruptedEvent{
+		Checkpoint: checkpoint,
+	})
+}
+
+// InterruptedSequence implements the controller interface.
+func (c *Controller) InterruptedSequence() *machine.SequenceCheckpoint {
+	c.interruptedMu.Lock()
+	defer c.interruptedMu.Unlock()
+
+	return c.interrupted
+}
+
+// ResolveInterruptedSequence implements the controller interface.
+//
+// Resuming runs the interrupted sequence from the beginning with the same
+// request, as the state of the node doesn't survive the reboot.
+// Rolling back is supported only for the upgrade sequence: it reverts the
+// bootloader to the installation which was running when the upgrade started.
+func (c *Controller) ResolveInterruptedSequence(action machine.InterruptedSequenceRequest_Action) error {
+	if action == machine.InterruptedSequenceRequest_STATUS {
+		return nil
+	}
+
+	checkpoint := c.InterruptedSequence()
+	if checkpoint == nil {
+		return runtime.ErrNoInterruptedSequence
+	}
+
+	seq, in, err := decodeCheckpoint(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case machine.InterruptedSequenceRequest_RESUME:
+		c.forgetInterruptedSequence()
+
+		log.Printf("resuming interrupted %s sequence", seq)
+
+		go func() {
+			if err := c.Run(seq, in); err != nil {
+				log.Printf("resumed %s sequence failed: %v", seq, err)
+			}
+		}()
+	case machine.InterruptedSequenceRequest_ROLLBACK:
+		if seq != runtime.SequenceUpgrade {
+			return fmt.Errorf("rollback is not supported for %s sequence", seq)
+		}
+
+		g := &grub.Grub{}
+
+		current, _, err := g.Labels()
+		if err != nil {
+			return fmt.Errorf("failed to read bootloader labels: %w", err)
+		}
+
+		c.forgetInterruptedSequence()
+
+		if checkpoint.BootLabel == "" || current == checkpoint.BootLabel {
+			log.Printf("bootloader was not changed by the interrupted %s sequence, nothing to roll back", seq)
+
+			return nil
+		}
+
+		if err = g.Default(checkpoint.BootLabel); err != nil {
+			return fmt.Errorf("failed to revert bootloader: %w", err)
+		}
+
+		log.Printf("reverted bootloader to %q, rebooting", checkpoint.BootLabel)
+
+		go func() {
+			if err := c.Run(runtime.SequenceReboot, nil, runtime.WithForce()); err != nil {
+				log.Printf("reboot failed: %v", err)
+			}
+		}()
+	case machine.InterruptedSequenceRequest_DISCARD:
+		log.Printf("discarding interrupted %s sequence", seq)
+
+		c.forgetInterruptedSequence()
+	default:
+		return fmt.Errorf("unsupported interrupted sequence action: %s", action)
+	}
+
+	return nil
+}
+
+func (c *Controller) forgetInterruptedSequence() {
+	c.interruptedMu.Lock()
+	c.interrupted = nil
+	c.interruptedMu.Unlock()
+
+	c.clearCheckpoint()
+}
+
 // ListenForEvents starts the event listener. The listener will trigger a
 // shutdown in response to a SIGTERM signal and ACPI button/power event.
 func (c *Controller) ListenForEvents() error {
@@ -233,6 +390,19 @@ func (c *Controller) run(seq runtime.Sequence, phases []runtime.Phase, data inte
 
 	log.Printf("%s sequence: %d phase(s)", seq.String(), len(phases))
 
+	// the context is canceled by the sequence cancel, which is refused once
+	// a destructive phase has started
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tracker := c.trackSequence(seq, len(phases), cancel)
+	defer c.untrackSequence(tracker)
+
+	checkpoint := c.newCheckpoint(seq, phases, data)
+	if checkpoint != nil {
+		defer c.clearCheckpoint()
+	}
+
 	defer func() {
 		if err != nil {
 			log.Printf("%s sequence: failed", seq.String())
@@ -249,9 +419,38 @@ func (c *Controller) run(seq runtime.Sequence, phases []runtime.Phase, data inte
 
 		progress := fmt.Sprintf("%d/%d", number, len(phases))
 
+		if tracker.enterPhase(number, phase.Name) {
+			log.Printf("%s sequence: canceled before phase %s (%s)", seq.String(), phase.Name, progress)
+
+			err = fmt.Errorf("%s sequence stopped before phase %d: %w", seq.String(), number, runtime.ErrCanceled)
+
+			return err
+		}
+
 		log.Printf("phase %s (%s): %d tasks(s)", phase.Name, progress, len(phase.Tasks))
 
-		if err = c.runPhase(phase, seq, data); err != nil {
+		if checkpoint != nil {
+			if number == len(phases) {
+				// the last phase of checkpointed sequences reboots the node, so the
+				// sequence should no longer be considered interrupted from this point
+				c.clearCheckpoint()
+			} else {
+				checkpoint.Phase = int32(number)
+				checkpoint.PhaseName = phase.Name
+
+				c.saveCheckpoint(checkpoint)
+			}
+		}
+
+		if err = c.runPhase(ctx, tracker, phase, seq, data); err != nil {
+			if ctx.Err() != nil {
+				log.Printf("phase %s (%s): canceled", phase.Name, progress)
+
+				err = fmt.Errorf("%s sequence canceled in phase %d: %w", seq.String(), number, runtime.ErrCanceled)
+
+				return err
+			}
+
 			log.Printf("phase %s (%s): failed", phase.Name, progress)
 
 			return fmt.Errorf("error running phase %d in %s sequence: %w", number, seq.String(), err)
@@ -263,7 +462,62 @@ func (c *Controller) run(seq runtime.Sequence, phases []runtime.Phase, data inte
 	return nil
 }
 
-func (c *Controller) runPhase(phase runtime.Phase, seq runtime.Sequence, data interface{}) error {
+// newCheckpoint returns a checkpoint template for the sequence, or nil if
+// the sequence progress should not be persisted.
+func (c *Controller) newCheckpoint(seq runtime.Sequence, phases []runtime.Phase, data interface{}) *machine.SequenceCheckpoint {
+	if c.checkpoints == nil {
+		return nil
+	}
+
+	if _, ok := checkpointedSequences[seq]; !ok {
+		return nil
+	}
+
+	in, ok := data.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	request, err := proto.Marshal(in)
+	if err != nil {
+		log.Printf("%s sequence: failed to marshal request for checkpoint: %v", seq, err)
+
+		return nil
+	}
+
+	checkpoint := &machine.SequenceCheckpoint{
+		Sequence:    seq.String(),
+		TotalPhases: int32(len(phases)),
+		Request:     request,
+	}
+
+	if current, _, err := (&grub.Grub{}).Labels(); err == nil {
+		checkpoint.BootLabel = current
+	}
+
+	return checkpoint
+}
+
+// saveCheckpoint persists sequence progress.
+//
+// Failure to save a checkpoint is not fatal for the sequence.
+func (c *Controller) saveCheckpoint(checkpoint *machine.SequenceCheckpoint) {
+	if err := c.checkpoints.Save(checkpoint); err != nil {
+		log.Printf("%s sequence: failed to save checkpoint: %v", checkpoint.Sequence, err)
+	}
+}
+
+func (c *Controller) clearCheckpoint() {
+	if c.checkpoints == nil {
+		return
+	}
+
+	if err := c.checkpoints.Clear(); err != nil {
+		log.Printf("failed to clear sequence checkpoint: %v", err)
+	}
+}
+
+func (c *Controller) runPhase(ctx context.Context, tracker *sequenceProgress, phase runtime.Phase, seq runtime.Sequence, data interface{}) error {
 	c.Runtime().Events().Publish(&machine.PhaseEvent{
 		Phase:  phase.Name,
 		Action: machine.PhaseEvent_START,
@@ -287,7 +541,7 @@ func (c *Controller) runPhase(phase runtime.Phase, seq runtime.Sequence, data in
 		eg.Go(func() error {
 			progress := fmt.Sprintf("%d/%d", number, len(phase.Tasks))
 
-			if err := c.runTask(progress, task, seq, data); err != nil {
+			if err := c.runTask(ctx, tracker, progress, task, seq, data); err != nil {
 				return fmt.Errorf("task %s: failed, %w", progress, err)
 			}
 
@@ -298,7 +552,7 @@ func (c *Controller) runPhase(phase runtime.Phase, seq runtime.Sequence, data in
 	return eg.Wait()
 }
 
-func (c *Controller) runTask(progress string, f runtime.TaskSetupFunc, seq runtime.Sequence, data interface{}) error {
+func (c *Controller) runTask(ctx context.Context, tracker *sequenceProgress, progress string, f runtime.TaskSetupFunc, seq runtime.Sequence, data interface{}) error {
 	task, taskName := f(seq, data)
 	if task == nil {
 		return nil
@@ -315,6 +569,9 @@ func (c *Controller) runTask(progress string, f runtime.TaskSetupFunc, seq runti
 
 	log.Printf("task %s (%s): starting", taskName, progress)
 
+	tracker.taskStarted(taskName)
+	defer tracker.taskFinished(taskName)
+
 	defer func() {
 		if err != nil {
 			log.Printf("task %s (%s): failed", taskName, progress)
@@ -343,7 +600,7 @@ func (c *Controller) runTask(progress string, f runtime.TaskSetupFunc, seq runti
 		return err
 	}
 
-	err = task(context.TODO(), logger, c.r)
+	err = task(ctx, logger, c.r)
 
 	return err
 }
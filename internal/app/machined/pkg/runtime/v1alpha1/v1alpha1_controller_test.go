@@ -6,6 +6,7 @@
 package v1alpha1
 
 import (
+	"context"
 	"reflect"
 	"strconv"
 	"testing"
@@ -286,7 +287,7 @@ func TestController_runPhase(t *testing.T) {
 				s:         tt.fields.s,
 				semaphore: tt.fields.semaphore,
 			}
-			if err := c.runPhase(tt.args.phase, tt.args.seq, tt.args.data); (err != nil) != tt.wantErr {
+			if err := c.runPhase(context.Background(), nil, tt.args.phase, tt.args.seq, tt.args.data); (err != nil) != tt.wantErr {
 				t.Errorf("Controller.runPhase() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -323,7 +324,7 @@ func TestController_runTask(t *testing.T) {
 				s:         tt.fields.s,
 				semaphore: tt.fields.semaphore,
 			}
-			if err := c.runTask(strconv.Itoa(tt.args.n), tt.args.f, tt.args.seq, tt.args.data); (err != nil) != tt.wantErr {
+			if err := c.runTask(context.Background(), nil, strconv.Itoa(tt.args.n), tt.args.f, tt.args.seq, tt.args.data); (err != nil) != tt.wantErr {
 				t.Errorf("Controller.runTask() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -0,0 +1,283 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+// destructivePhases can't be interrupted safely, once any of them starts
+// the sequence can no longer be canceled.
+//
+// Stopping the services and unmounting the filesystems leaves the node
+// unusable until it reboots, so these phases commit the sequence as well.
+var destructivePhases = map[string]struct{}{
+	"leave":          {},
+	"reset":          {},
+	"stage":          {},
+	"stopServices":   {},
+	"stopEverything": {},
+	"umount":         {},
+	"unmount":        {},
+	"unmountSystem":  {},
+	"unmountState":   {},
+	"unmountBind":    {},
+	"upgrade":        {},
+	"reboot":         {},
+	"shutdown":       {},
+}
+
+// sequenceProgress tracks the progress of a running sequence.
+//
+// All methods are safe to call on nil sequenceProgress.
+type sequenceProgress struct {
+	mu sync.Mutex
+
+	seq       runtime.Sequence
+	cancel    context.CancelFunc
+	started   time.Time
+	canceled  bool
+	committed string
+	phase     int
+	phaseName string
+	total     int
+	tasks     []string
+}
+
+// enterPhase records the phase as started unless the cancel was requested.
+func (p *sequenceProgress) enterPhase(number int, name string) (canceled bool) {
+	if p == nil {
+		return false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.canceled {
+		return true
+	}
+
+	p.phase = number
+	p.phaseName = name
+
+	if _, ok := destructivePhases[name]; ok && p.committed == "" {
+		p.committed = name
+	}
+
+	return false
+}
+
+func (p *sequenceProgress) taskStarted(name string) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.tasks = append(p.tasks, name)
+}
+
+func (p *sequenceProgress) taskFinished(name string) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i := range p.tasks {
+		if p.tasks[i] == name {
+			p.tasks = append(p.tasks[:i], p.tasks[i+1:]...)
+
+			break
+		}
+	}
+}
+
+// requestCancel stops the sequence before the next phase and cancels the
+// context of the running tasks.
+func (p *sequenceProgress) requestCancel() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.committed != "" {
+		return fmt.Errorf("sequence %s can't be canceled after phase %s started", p.seq, p.committed)
+	}
+
+	p.canceled = true
+
+	if p.cancel != nil {
+		p.cancel()
+	}
+
+	return nil
+}
+
+func (p *sequenceProgress) AsProto() *machine.RunningSequence {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// nolint: errcheck
+	started, _ := ptypes.TimestampProto(p.started)
+
+	return &machine.RunningSequence{
+		Sequence:    p.seq.String(),
+		Phase:       int32(p.phase),
+		PhaseName:   p.phaseName,
+		TotalPhases: int32(p.total),
+		Tasks:       append([]string(nil), p.tasks...),
+		Started:     started,
+		Canceled:    p.canceled,
+	}
+}
+
+// trackSequence registers the sequence as running, cancel is called once
+// the sequence cancel is requested.
+func (c *Controller) trackSequence(seq runtime.Sequence, total int, cancel context.CancelFunc) *sequenceProgress {
+	progress := &sequenceProgress{
+		seq:     seq,
+		cancel:  cancel,
+		started: time.Now(),
+		total:   total,
+	}
+
+	c.progressMu.Lock()
+	defer c.progressMu.Unlock()
+
+	c.running = append(c.running, progress)
+
+	return progress
+}
+
+// untrackSequence removes the sequence from the list of running sequences.
+func (c *Controller) untrackSequence(progress *sequenceProgress) {
+	c.progressMu.Lock()
+	defer c.progressMu.Unlock()
+
+	for i := range c.running {
+		if c.running[i] == progress {
+			c.running = append(c.running[:i], c.running[i+1:]...)
+
+			break
+		}
+	}
+}
+
+// Sequences implements the controller interface.
+func (c *Controller) Sequences() *machine.Sequences {
+	c.progressMu.Lock()
+	defer c.progressMu.Unlock()
+
+	result := &machine.Sequences{}
+
+	for _, progress := range c.running {
+		result.Running = append(result.Running, progress.AsProto())
+	}
+
+	for _, seq := range c.queue {
+		result.Queued = append(result.Queued, seq.String())
+	}
+
+	return result
+}
+
+// CancelSequence implements the controller interface.
+//
+// The context of the running tasks is canceled, and the sequence stops before
+// the next phase. Once a destructive phase starts, the sequence can't be
+// canceled.
+func (c *Controller) CancelSequence(seq runtime.Sequence) error {
+	switch seq { //nolint: exhaustive
+	case runtime.SequenceInitialize, runtime.SequenceInstall, runtime.SequenceBoot:
+		// the node is not functional if these sequences don't complete
+		return fmt.Errorf("sequence %s can't be canceled", seq)
+	}
+
+	c.progressMu.Lock()
+	defer c.progressMu.Unlock()
+
+	found := false
+
+	for _, progress := range c.running {
+		if progress.seq == seq {
+			if err := progress.requestCancel(); err != nil {
+				return err
+			}
+
+			found = true
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("sequence %s is not running", seq)
+	}
+
+	log.Printf("%s sequence: cancel requested", seq)
+
+	return nil
+}
+
+// QueueSequence implements the controller interface.
+//
+// Only the sequences which don't require any input can be queued.
+func (c *Controller) QueueSequence(seq runtime.Sequence) error {
+	switch seq { //nolint: exhaustive
+	case runtime.SequenceReboot, runtime.SequenceShutdown:
+	default:
+		return fmt.Errorf("sequence %s can't be queued", seq)
+	}
+
+	c.progressMu.Lock()
+	c.queue = append(c.queue, seq)
+	c.progressMu.Unlock()
+
+	log.Printf("%s sequence: queued", seq)
+
+	c.runQueue()
+
+	return nil
+}
+
+// runQueue starts the next queued sequence once no other sequence is running.
+//
+// The bootstrap, reset and recover sequences don't take the lock, so the
+// queued sequence waits for every running sequence, not only for the lock.
+func (c *Controller) runQueue() {
+	c.progressMu.Lock()
+	defer c.progressMu.Unlock()
+
+	if len(c.queue) == 0 || len(c.running) > 0 {
+		// runQueue is called again once the running sequences are done
+		return
+	}
+
+	if c.TryLock() {
+		// sequence is running, runQueue is called again once it's done
+		return
+	}
+
+	seq := c.queue[0]
+	c.queue = c.queue[1:]
+
+	go func() {
+		defer c.runQueue()
+		defer c.Unlock()
+
+		// lock is already held, so force the sequence to skip locking
+		if err := c.Run(seq, nil, runtime.WithForce()); err != nil {
+			log.Printf("queued %s sequence failed: %v", seq, err)
+		}
+	}()
+}
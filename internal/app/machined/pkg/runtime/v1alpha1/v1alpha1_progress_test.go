@@ -0,0 +1,192 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint: testpackage
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+)
+
+func TestControllerCancelSequence(t *testing.T) {
+	c := newCheckpointTestController(nil)
+
+	taskStarted := make(chan struct{})
+
+	blockingTask := func(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+		return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+			close(taskStarted)
+
+			// the running task is notified via its context
+			<-ctx.Done()
+
+			return ctx.Err()
+		}, "blocking"
+	}
+
+	secondPhaseRan := false
+
+	secondTask := func(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+		return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+			secondPhaseRan = true
+
+			return nil
+		}, "second"
+	}
+
+	phases := PhaseList{}.Append("wait", blockingTask).Append("reboot", secondTask)
+
+	assert.EqualError(t, c.CancelSequence(runtime.SequenceReboot), "sequence reboot is not running")
+	assert.EqualError(t, c.CancelSequence(runtime.SequenceBoot), "sequence boot can't be canceled")
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- c.run(runtime.SequenceReboot, phases, nil)
+	}()
+
+	<-taskStarted
+
+	sequences := c.Sequences()
+	require.Len(t, sequences.Running, 1)
+	assert.Equal(t, "reboot", sequences.Running[0].Sequence)
+	assert.Equal(t, "wait", sequences.Running[0].PhaseName)
+	assert.EqualValues(t, 1, sequences.Running[0].Phase)
+	assert.EqualValues(t, 2, sequences.Running[0].TotalPhases)
+	assert.Equal(t, []string{"blocking"}, sequences.Running[0].Tasks)
+
+	require.NoError(t, c.CancelSequence(runtime.SequenceReboot))
+
+	err := <-errCh
+	assert.True(t, errors.Is(err, runtime.ErrCanceled))
+	assert.False(t, secondPhaseRan)
+
+	assert.Empty(t, c.Sequences().Running)
+}
+
+func TestControllerCancelSequenceDestructive(t *testing.T) {
+	c := newCheckpointTestController(nil)
+
+	taskStarted := make(chan struct{})
+	taskReleased := make(chan struct{})
+
+	blockingTask := func(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+		return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+			close(taskStarted)
+
+			<-taskReleased
+
+			return nil
+		}, "blocking"
+	}
+
+	secondPhaseRan := false
+
+	secondTask := func(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+		return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+			secondPhaseRan = true
+
+			return nil
+		}, "second"
+	}
+
+	phases := PhaseList{}.Append("reset", blockingTask).Append("reboot", secondTask)
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- c.run(runtime.SequenceReset, phases, nil)
+	}()
+
+	<-taskStarted
+
+	assert.EqualError(t, c.CancelSequence(runtime.SequenceReset), "sequence reset can't be canceled after phase reset started")
+
+	close(taskReleased)
+
+	assert.NoError(t, <-errCh)
+	assert.True(t, secondPhaseRan)
+}
+
+func TestControllerCancelPreserveUpgrade(t *testing.T) {
+	c := newCheckpointTestController(nil)
+
+	taskStarted := make(chan struct{})
+	taskReleased := make(chan struct{})
+
+	noopTask := func(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+		return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+			return nil
+		}, "noop"
+	}
+
+	blockingTask := func(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+		return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+			close(taskStarted)
+
+			<-taskReleased
+
+			return nil
+		}, "blocking"
+	}
+
+	// the preserve upgrade doesn't leave etcd, the services are stopped right
+	// after the drain
+	phases := PhaseList{}.
+		Append("drain", noopTask).
+		Append("stopServices", blockingTask).
+		Append("unmount", noopTask).
+		Append("unmountSystem", noopTask)
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- c.run(runtime.SequenceUpgrade, phases, nil)
+	}()
+
+	<-taskStarted
+
+	assert.EqualError(t, c.CancelSequence(runtime.SequenceUpgrade), "sequence upgrade can't be canceled after phase stopServices started")
+
+	close(taskReleased)
+
+	assert.NoError(t, <-errCh)
+}
+
+func TestControllerQueueSequence(t *testing.T) {
+	c := newCheckpointTestController(nil)
+
+	// simulate a running sequence
+	require.False(t, c.TryLock())
+
+	assert.EqualError(t, c.QueueSequence(runtime.SequenceUpgrade), "sequence upgrade can't be queued")
+
+	require.NoError(t, c.QueueSequence(runtime.SequenceReboot))
+
+	assert.Equal(t, []string{"reboot"}, c.Sequences().Queued)
+}
+
+func TestControllerQueueSequenceUnlocked(t *testing.T) {
+	c := newCheckpointTestController(nil)
+
+	// simulate a running reset sequence, which doesn't take the lock
+	progress := c.trackSequence(runtime.SequenceReset, 1, nil)
+	defer c.untrackSequence(progress)
+
+	require.NoError(t, c.QueueSequence(runtime.SequenceReboot))
+
+	assert.Equal(t, []string{"reboot"}, c.Sequences().Queued)
+
+	// the queued sequence didn't take the lock
+	require.False(t, c.TryLock())
+	require.True(t, c.Unlock())
+}
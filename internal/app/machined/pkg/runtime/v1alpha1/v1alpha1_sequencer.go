@@ -347,6 +347,27 @@ func (*Sequencer) Upgrade(r runtime.Runtime, in *machineapi.UpgradeRequest) []ru
 	case runtime.ModeContainer:
 		return nil
 	default:
+		// A staged upgrade is installed while the node keeps running.
+		if in.GetStage() {
+			return phases.Append(
+				"stage",
+				StageUpgrade,
+			)
+		}
+
+		// The staged upgrade is already installed, it only takes a reboot.
+		if in.GetCommit() {
+			return phases.Append(
+				"drain",
+				CordonAndDrainNode,
+			).AppendList(
+				stopAllPhaselist(r),
+			).Append(
+				"reboot",
+				Reboot,
+			)
+		}
+
 		phases = phases.Append(
 			"drain",
 			CordonAndDrainNode,
@@ -23,6 +23,7 @@ import (
 	"text/template"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/hashicorp/go-multierror"
 	"go.etcd.io/etcd/clientv3"
 	"golang.org/x/sys/unix"
@@ -32,6 +33,7 @@ import (
 
 	"github.com/talos-systems/go-blockdevice/blockdevice"
 	"github.com/talos-systems/go-blockdevice/blockdevice/table"
+	"github.com/talos-systems/go-blockdevice/blockdevice/table/gpt/partition"
 	"github.com/talos-systems/go-blockdevice/blockdevice/util"
 
 	installer "github.com/talos-systems/talos/cmd/installer/pkg/install"
@@ -50,13 +52,16 @@ import (
 	"github.com/talos-systems/talos/internal/pkg/kmsg"
 	"github.com/talos-systems/talos/internal/pkg/kubeconfig"
 	"github.com/talos-systems/talos/internal/pkg/mount"
+	"github.com/talos-systems/talos/internal/pkg/raid"
 	"github.com/talos-systems/talos/pkg/cmd"
 	"github.com/talos-systems/talos/pkg/conditions"
 	"github.com/talos-systems/talos/pkg/kubernetes"
 	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/disk"
 	"github.com/talos-systems/talos/pkg/sysctl"
 	"github.com/talos-systems/talos/pkg/version"
 )
@@ -606,29 +611,29 @@ func StartAllServices(seq runtime.Sequence, data interface{}) (runtime.TaskExecu
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
 		svcs := system.Services(r)
 
-		svcs.Load(
+		loadServices := []system.Service{
 			&services.APID{},
 			&services.Routerd{},
 			&services.Networkd{},
 			&services.CRI{},
 			&services.Kubelet{},
-		)
+		}
 
 		if r.State().Platform().Mode() != runtime.ModeContainer && r.Config().Machine().Time().Enabled() {
-			svcs.Load(
+			loadServices = append(loadServices,
 				&services.Timed{},
 			)
 		}
 
 		switch r.Config().Machine().Type() {
 		case machine.TypeInit:
-			svcs.Load(
+			loadServices = append(loadServices,
 				&services.Trustd{},
 				&services.Etcd{Bootstrap: true},
 				&services.Bootkube{},
 			)
 		case machine.TypeControlPlane:
-			svcs.Load(
+			loadServices = append(loadServices,
 				&services.Trustd{},
 				&services.Etcd{},
 			)
@@ -637,6 +642,22 @@ func StartAllServices(seq runtime.Sequence, data interface{}) (runtime.TaskExecu
 			return fmt.Errorf("unexpected machine type: %s", r.Config().Machine().Type())
 		}
 
+		if r.Config().Machine().Type() != machine.TypeJoin && r.Config().Cluster().Etcd().Backup().Enabled() {
+			loadServices = append(loadServices,
+				&services.EtcdBackup{},
+			)
+		}
+
+		if r.Config().Machine().Type() != machine.TypeJoin && r.Config().Cluster().ManifestSync().Enabled() {
+			loadServices = append(loadServices,
+				&services.ManifestSync{},
+			)
+		}
+
+		if _, err = svcs.Load(loadServices...); err != nil {
+			return fmt.Errorf("failed to load services: %w", err)
+		}
+
 		system.Services(r).StartAll()
 
 		all := []conditions.Condition{}
@@ -687,7 +708,7 @@ func VerifyInstallation(seq runtime.Sequence, data interface{}) (runtime.TaskExe
 		)
 
 		grub := &grub.Grub{
-			BootDisk: r.Config().Machine().Install().Disk(),
+			BootDisk: runtime.SystemDisk(r),
 		}
 
 		current, next, err = grub.Labels()
@@ -744,6 +765,17 @@ func SetupVarDirectory(seq runtime.Sequence, data interface{}) (runtime.TaskExec
 			}
 		}
 
+		if r.Config().Machine().Type() != machine.TypeJoin {
+			// the apiserver writes the audit log as the non-root user
+			if err = os.MkdirAll(constants.KubernetesAuditLogDir, 0o700); err != nil {
+				return err
+			}
+
+			if err = os.Chown(constants.KubernetesAuditLogDir, constants.KubernetesAPIServerRunUser, constants.KubernetesAPIServerRunUser); err != nil {
+				return err
+			}
+		}
+
 		return nil
 	}, "setupVarDirectory"
 }
@@ -767,35 +799,20 @@ func partitionAndFormatDisks(logger *log.Logger, r runtime.Runtime) (err error)
 	}
 
 	for _, disk := range r.Config().Machine().Disks() {
-		var bd *blockdevice.BlockDevice
-
-		bd, err = blockdevice.Open(disk.Device())
-		if err != nil {
-			return err
-		}
-
-		// nolint: errcheck
-		defer bd.Close()
-
-		var pt table.PartitionTable
-
-		pt, err = bd.PartitionTable()
-		if err != nil {
-			if !errors.Is(err, blockdevice.ErrMissingPartitionTable) {
+		if disk.RAID() != nil {
+			if err = assembleRAID(logger, disk); err != nil {
 				return err
 			}
 		}
 
-		// Partitions will be created/recreated if either of the following
-		//  conditions are true:
-		// - a partition table exists AND there are no partitions
-		// - a partition table does not exist
+		var setup bool
 
-		if pt != nil {
-			if len(pt.Partitions()) > 0 {
-				logger.Printf(("skipping setup of %q, found existing partitions"), disk.Device())
-				continue
-			}
+		if setup, err = diskNeedsSetup(logger, disk); err != nil {
+			return err
+		}
+
+		if !setup {
+			continue
 		}
 
 		if m.Targets[disk.Device()] == nil {
@@ -804,10 +821,12 @@ func partitionAndFormatDisks(logger *log.Logger, r runtime.Runtime) (err error)
 
 		for _, part := range disk.Partitions() {
 			extraTarget := &installer.Target{
-				Device: disk.Device(),
-				Size:   part.Size(),
-				Force:  true,
-				Test:   false,
+				Device:         disk.Device(),
+				Label:          part.Label(),
+				FileSystemType: part.Filesystem(),
+				Size:           part.Size(),
+				Force:          true,
+				Test:           false,
 			}
 
 			m.Targets[disk.Device()] = append(m.Targets[disk.Device()], extraTarget)
@@ -821,14 +840,113 @@ func partitionAndFormatDisks(logger *log.Logger, r runtime.Runtime) (err error)
 	return nil
 }
 
+// assembleRAID starts the RAID array of the disk. The array is created only if
+// the member devices are empty, or if the wipe policy allows to wipe them.
+func assembleRAID(logger *log.Logger, disk config.Disk) error {
+	assembled, err := raid.Assemble(disk.Device(), disk.RAID().Devices())
+	if err != nil || assembled {
+		return err
+	}
+
+	if disk.Wipe() == constants.DiskWipeNever {
+		for _, dev := range disk.RAID().Devices() {
+			var empty bool
+
+			if empty, err = raid.Empty(dev); err != nil {
+				return err
+			}
+
+			if !empty {
+				return fmt.Errorf("refusing to create RAID array %q: %q has existing data and the wipe policy is %q", disk.Device(), dev, disk.Wipe())
+			}
+		}
+	}
+
+	logger.Printf("creating RAID array %q from %q", disk.Device(), disk.RAID().Devices())
+
+	return raid.Create(disk.Device(), disk.RAID().Level(), disk.RAID().Devices())
+}
+
+// diskNeedsSetup checks whether the disk should be (re)partitioned according
+// to the wipe policy.
+func diskNeedsSetup(logger *log.Logger, disk config.Disk) (bool, error) {
+	bd, err := blockdevice.Open(disk.Device())
+	if err != nil {
+		return false, err
+	}
+
+	// nolint: errcheck
+	defer bd.Close()
+
+	pt, err := bd.PartitionTable()
+	if err != nil {
+		if !errors.Is(err, blockdevice.ErrMissingPartitionTable) {
+			return false, err
+		}
+	}
+
+	// Partitions will be created/recreated if either of the following
+	//  conditions are true:
+	// - a partition table exists AND there are no partitions
+	// - a partition table does not exist
+
+	if pt == nil || len(pt.Partitions()) == 0 {
+		return true, nil
+	}
+
+	switch disk.Wipe() {
+	case constants.DiskWipeAlways:
+		logger.Printf("wiping %q", disk.Device())
+
+		return true, nil
+	case constants.DiskWipeMismatch:
+		if partitionsMatch(pt.Partitions(), disk.Partitions()) {
+			logger.Printf("skipping setup of %q, existing partitions match the config", disk.Device())
+
+			return false, nil
+		}
+
+		logger.Printf("wiping %q, existing partitions don't match the config", disk.Device())
+
+		return true, nil
+	default:
+		logger.Printf("skipping setup of %q, found existing partitions", disk.Device())
+
+		return false, nil
+	}
+}
+
+// partitionsMatch compares the number of partitions and the labels set in the
+// config.
+func partitionsMatch(existing []table.Partition, configured []config.Partition) bool {
+	if len(existing) != len(configured) {
+		return false
+	}
+
+	for i, part := range configured {
+		if part.Label() == "" {
+			continue
+		}
+
+		p, ok := existing[i].(*partition.Partition)
+		if !ok || p.Name != part.Label() {
+			return false
+		}
+	}
+
+	return true
+}
+
 func mountDisks(r runtime.Runtime) (err error) {
 	mountpoints := mount.NewMountPoints()
 
 	for _, disk := range r.Config().Machine().Disks() {
-		for i, part := range disk.Partitions() {
+		partitions := disk.Partitions()
+
+		for i, part := range partitions {
 			var partname string
 
-			partname, err = util.PartPath(disk.Device(), i+1)
+			partname, err = raid.PartPath(disk.Device(), i+1)
 			if err != nil {
 				return err
 			}
@@ -839,7 +957,12 @@ func mountDisks(r runtime.Runtime) (err error) {
 				}
 			}
 
-			mountpoints.Set(partname, mount.NewMountPoint(partname, part.MountPoint(), "xfs", unix.MS_NOATIME, ""))
+			flags, data := mount.ParseOptions(part.MountOptions())
+
+			// the partition which occupies the rest of the disk follows the disk growth
+			resize := i == len(partitions)-1 && part.Size() == 0 && part.Filesystem() != "vfat"
+
+			mountpoints.Set(partname, mount.NewMountPoint(partname, part.MountPoint(), part.Filesystem(), flags, data, mount.WithResize(resize)))
 		}
 	}
 
@@ -1258,6 +1381,14 @@ func ResetSystemDisk(seq runtime.Sequence, data interface{}) (runtime.TaskExecut
 // disk is not in use.
 func VerifyDiskAvailability(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		// the system disk might not be found, verifySystemDisk skips the check
+		// if the install disk selector is not set
+		if devname := runtime.SystemDisk(r); devname != "" {
+			if err = verifySystemDisk(devname, r.Config().Machine().Install()); err != nil {
+				return err
+			}
+		}
+
 		//  We only need to verify system disk availability if we are going to
 		// reformat the ephemeral partition.
 		if !r.Config().Machine().Install().Force() {
@@ -1353,6 +1484,8 @@ func Upgrade(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc,
 			install.WithUpgrade(true),
 			install.WithForce(!in.GetPreserve()),
 			install.WithExtraKernelArgs(r.Config().Machine().Install().ExtraKernelArgs()),
+			install.WithBootAttempts(r.Config().Machine().Install().UpgradeHealthGate().BootAttempts()),
+			install.WithEncryptedPartitions(encryptedPartitions(r)),
 		)
 		if err != nil {
 			return err
@@ -1364,6 +1497,88 @@ func Upgrade(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc,
 	}, "upgrade"
 }
 
+// StageUpgrade represents the task for staging an upgrade. The image is
+// installed as the next boot label while the node keeps running. The task
+// never fails, as a failed sequence reboots the node, the outcome is recorded
+// in the upgrade status instead.
+func StageUpgrade(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		in, ok := data.(*machineapi.UpgradeRequest)
+		if !ok {
+			return runtime.ErrInvalidSequenceData
+		}
+
+		r.State().Machine().SetStagedUpgrade(&machineapi.UpgradeStatus{
+			Stage:   machineapi.UpgradeStatus_STAGING,
+			Image:   in.GetImage(),
+			Updated: ptypes.TimestampNow(),
+		})
+
+		devname := r.State().Machine().Disk().BlockDevice.Device().Name()
+
+		logger.Printf("staging upgrade via %q", in.GetImage())
+
+		// We pull the installer image when we receive an upgrade request. No need
+		// to pull it again.
+		err = install.RunInstallerContainer(
+			devname, r.State().Platform().Name(),
+			in.GetImage(),
+			r.Config().Machine().Registries(),
+			install.WithPull(false),
+			install.WithUpgrade(true),
+			install.WithStage(true),
+			install.WithForce(false),
+			install.WithExtraKernelArgs(r.Config().Machine().Install().ExtraKernelArgs()),
+			install.WithBootAttempts(r.Config().Machine().Install().UpgradeHealthGate().BootAttempts()),
+			install.WithEncryptedPartitions(encryptedPartitions(r)),
+		)
+
+		var label string
+
+		if err == nil {
+			label, err = stagedLabel()
+		}
+
+		// the status is replaced, as the previous one may be read concurrently
+		status := &machineapi.UpgradeStatus{
+			Stage:   machineapi.UpgradeStatus_STAGED,
+			Image:   in.GetImage(),
+			Label:   label,
+			Updated: ptypes.TimestampNow(),
+		}
+
+		if err != nil {
+			logger.Printf("failed to stage upgrade: %s", err)
+
+			status.Stage = machineapi.UpgradeStatus_FAILED
+			status.Error = err.Error()
+		} else {
+			logger.Printf("upgrade staged to label %q", status.Label)
+		}
+
+		r.State().Machine().SetStagedUpgrade(status)
+
+		return nil
+	}, "stageUpgrade"
+}
+
+// stagedLabel returns the label the installer staged the upgrade to.
+func stagedLabel() (string, error) {
+	meta, err := bootloader.NewMeta()
+	if err != nil {
+		return "", err
+	}
+	// nolint: errcheck
+	defer meta.Close()
+
+	label, ok := meta.ReadTag(bootloader.AdvUpgradeTrial)
+	if !ok {
+		return "", fmt.Errorf("installer did not record the staged label")
+	}
+
+	return label, nil
+}
+
 // LabelNodeAsMaster represents the LabelNodeAsMaster task.
 func LabelNodeAsMaster(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
@@ -1393,7 +1608,10 @@ func LabelNodeAsMaster(seq runtime.Sequence, data interface{}) (runtime.TaskExec
 	}, "labelNodeAsMaster"
 }
 
-// UpdateBootloader represents the UpdateBootloader task.
+// UpdateBootloader represents the UpdateBootloader task. An upgraded image
+// booted on trial is confirmed once the node passes the health gate. The boot
+// attempts are counted by grub, which boots the previous image once they are
+// exhausted, the previous image is then made the default again.
 func UpdateBootloader(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
 		meta, err := bootloader.NewMeta()
@@ -1403,7 +1621,36 @@ func UpdateBootloader(seq runtime.Sequence, data interface{}) (runtime.TaskExecu
 		// nolint: errcheck
 		defer meta.Close()
 
-		if ok := meta.DeleteTag(bootloader.AdvUpgrade); ok {
+		g := &grub.Grub{
+			BootDisk: runtime.SystemDisk(r),
+		}
+
+		label, failed, err := g.Trial()
+		if err != nil {
+			return err
+		}
+
+		if failed {
+			logger.Printf("upgraded image %q failed to boot, reverting to the previous image", label)
+
+			return meta.Revert()
+		}
+
+		if _, trial := meta.ReadTag(bootloader.AdvUpgradeTrial); trial {
+			gate := r.Config().Machine().Install().UpgradeHealthGate()
+
+			logger.Printf("waiting up to %s for the upgrade health gate", gate.Timeout())
+
+			if err = waitForHealthGate(ctx, r, gate); err != nil {
+				return fmt.Errorf("upgrade health gate failed: %w", err)
+			}
+		}
+
+		if err = g.ResetTrial(); err != nil {
+			return err
+		}
+
+		if ok := meta.ConfirmTrial(); ok {
 			logger.Println("removing fallback")
 
 			if _, err = meta.Write(); err != nil {
@@ -1415,6 +1662,41 @@ func UpdateBootloader(seq runtime.Sequence, data interface{}) (runtime.TaskExecu
 	}, "updateBootloader"
 }
 
+// waitForHealthGate waits for all the services to be up, and for the node to
+// be ready in Kubernetes.
+func waitForHealthGate(ctx context.Context, r runtime.Runtime, gate config.UpgradeHealthGate) error {
+	ctx, cancel := context.WithTimeout(ctx, gate.Timeout())
+	defer cancel()
+
+	all := []conditions.Condition{}
+
+	for _, svc := range system.Services(r).List() {
+		all = append(all, system.WaitForService(system.StateEventUp, svc.AsProto().GetId()))
+	}
+
+	if err := conditions.WaitForAll(all...).Wait(ctx); err != nil {
+		return err
+	}
+
+	if gate.SkipKubelet() {
+		return nil
+	}
+
+	deadline, _ := ctx.Deadline()
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+
+	kubeHelper, err := kubernetes.NewClientFromKubeletKubeconfig()
+	if err != nil {
+		return err
+	}
+
+	return kubeHelper.WaitUntilReadyWithTimeout(hostname, time.Until(deadline))
+}
+
 // Reboot represents the Reboot task.
 func Reboot(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
@@ -1467,7 +1749,7 @@ func SyncNonVolatileStorageBuffers() {
 // MountBootPartition mounts the boot partition.
 func MountBootPartition(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
-		return mountSystemPartition(constants.BootPartitionLabel)
+		return mountSystemPartition(r, constants.BootPartitionLabel)
 	}, "mountBootPartition"
 }
 
@@ -1481,7 +1763,7 @@ func UnmountBootPartition(seq runtime.Sequence, data interface{}) (runtime.TaskE
 // MountEFIPartition mounts the EFI partition.
 func MountEFIPartition(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
-		return mountSystemPartition(constants.EFIPartitionLabel)
+		return mountSystemPartition(r, constants.EFIPartitionLabel)
 	}, "mountEFIPartition"
 }
 
@@ -1495,7 +1777,7 @@ func UnmountEFIPartition(seq runtime.Sequence, data interface{}) (runtime.TaskEx
 // MountStatePartition mounts the system partition.
 func MountStatePartition(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
-		return mountSystemPartition(constants.StatePartitionLabel, mount.WithSkipIfMounted(true))
+		return mountSystemPartition(r, constants.StatePartitionLabel, mount.WithSkipIfMounted(true))
 	}, "mountStatePartition"
 }
 
@@ -1509,7 +1791,7 @@ func UnmountStatePartition(seq runtime.Sequence, data interface{}) (runtime.Task
 // MountEphermeralPartition mounts the ephemeral partition.
 func MountEphermeralPartition(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
-		return mountSystemPartition(constants.EphemeralPartitionLabel)
+		return mountSystemPartition(r, constants.EphemeralPartitionLabel)
 	}, "mountEphermeralPartition"
 }
 
@@ -1520,9 +1802,17 @@ func UnmountEphemeralPartition(seq runtime.Sequence, data interface{}) (runtime.
 	}, "unmountEphemeralPartition"
 }
 
-func mountSystemPartition(label string, opts ...mount.Option) (err error) {
+func mountSystemPartition(r runtime.Runtime, label string, opts ...mount.Option) (err error) {
 	mountpoints := mount.NewMountPoints()
 
+	opts = append(opts, mount.WithNodeSecret(bootloader.NodeSecret))
+
+	// The config is not loaded yet when STATE is mounted during the
+	// initialization, the keys are then taken from the LUKS2 header.
+	if r.Config() != nil {
+		opts = append(opts, mount.WithEncryption(r.Config().Machine().SystemDiskEncryption().Get(label)))
+	}
+
 	mountpoint, err := mount.SystemMountPointForLabel(label, opts...)
 	if err != nil {
 		return err
@@ -1565,14 +1855,22 @@ func unmountSystemPartition(label string) (err error) {
 // Install mounts or installs the system partitions.
 func Install(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		var devname string
+
+		devname, err = installDisk(r.Config().Machine().Install())
+		if err != nil {
+			return err
+		}
+
 		err = install.RunInstallerContainer(
-			r.Config().Machine().Install().Disk(),
+			devname,
 			r.State().Platform().Name(),
 			r.Config().Machine().Install().Image(),
 			r.Config().Machine().Registries(),
 			install.WithForce(r.Config().Machine().Install().Force()),
 			install.WithZero(r.Config().Machine().Install().Zero()),
 			install.WithExtraKernelArgs(r.Config().Machine().Install().ExtraKernelArgs()),
+			install.WithEncryptedPartitions(encryptedPartitions(r)),
 		)
 		if err != nil {
 			return err
@@ -1584,6 +1882,67 @@ func Install(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc,
 	}, "install"
 }
 
+// installDisk returns the disk to install to. The disk selector is resolved
+// against the disks attached to the node only at the install time, as more
+// disks might match it once the node is running.
+func installDisk(cfg config.Install) (string, error) {
+	matchers, err := cfg.DiskMatchers()
+	if err != nil {
+		return "", err
+	}
+
+	if matchers == nil {
+		return cfg.Disk(), nil
+	}
+
+	disks, err := disk.List()
+	if err != nil {
+		return "", err
+	}
+
+	d, err := disk.Find(disks, matchers...)
+	if err != nil {
+		return "", fmt.Errorf("failed to look up install disk: %w", err)
+	}
+
+	return d.DeviceName, nil
+}
+
+// verifySystemDisk checks that the installed system disk still matches the
+// install disk selector, the selector isn't resolved again as more disks
+// might match it.
+func verifySystemDisk(devname string, cfg config.Install) error {
+	matchers, err := cfg.DiskMatchers()
+	if err != nil || matchers == nil {
+		return err
+	}
+
+	d, err := disk.Probe(devname)
+	if err != nil {
+		return err
+	}
+
+	if !disk.Matches(d, matchers...) {
+		return fmt.Errorf("system disk %s doesn't match the install disk selector", d)
+	}
+
+	return nil
+}
+
+// encryptedPartitions returns the labels of the system partitions with the
+// encryption configured.
+func encryptedPartitions(r runtime.Runtime) []string {
+	labels := []string{}
+
+	for _, label := range []string{constants.StatePartitionLabel, constants.EphemeralPartitionLabel} {
+		if r.Config().Machine().SystemDiskEncryption().Get(label) != nil {
+			labels = append(labels, label)
+		}
+	}
+
+	return labels
+}
+
 // Recover attempts to recover the control plane.
 //
 // nolint: gocyclo
@@ -1621,7 +1980,9 @@ func Recover(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc,
 			return err
 		}
 
-		system.Services(r).Load(svc)
+		if _, err = system.Services(r).Load(svc); err != nil {
+			return fmt.Errorf("failed to load bootkube: %w", err)
+		}
 
 		if err = system.Services(r).Start(svc.ID(r)); err != nil {
 			return fmt.Errorf("failed to start bootkube: %w", err)
@@ -1637,6 +1998,11 @@ func Recover(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc,
 // BootstrapEtcd represents the task for bootstrapping etcd.
 func BootstrapEtcd(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		in, ok := data.(*machineapi.BootstrapRequest)
+		if !ok {
+			return runtime.ErrInvalidSequenceData
+		}
+
 		if err = system.Services(r).Stop(ctx, "etcd"); err != nil {
 			return fmt.Errorf("failed to stop etcd: %w", err)
 		}
@@ -1674,13 +2040,19 @@ func BootstrapEtcd(seq runtime.Sequence, data interface{}) (runtime.TaskExecutio
 			}
 		}
 
-		svc := &services.Etcd{Bootstrap: true}
+		svc := &services.Etcd{
+			Bootstrap:            true,
+			RecoverFromSnapshot:  in.GetRecoverEtcd(),
+			RecoverSkipHashCheck: in.GetRecoverSkipHashCheck(),
+		}
 
 		if err = system.Services(r).Unload(ctx, svc.ID(r)); err != nil {
 			return err
 		}
 
-		system.Services(r).Load(svc)
+		if _, err = system.Services(r).Load(svc); err != nil {
+			return fmt.Errorf("error loading etcd in bootstrap mode: %w", err)
+		}
 
 		if err = system.Services(r).Start(svc.ID(r)); err != nil {
 			return fmt.Errorf("error starting etcd in bootstrap mode: %w", err)
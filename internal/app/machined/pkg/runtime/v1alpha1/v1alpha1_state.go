@@ -7,11 +7,15 @@ package v1alpha1
 import (
 	"errors"
 	"os"
+	"sync"
 
+	"github.com/talos-systems/go-blockdevice/blockdevice"
 	"github.com/talos-systems/go-blockdevice/blockdevice/probe"
+	"github.com/talos-systems/go-blockdevice/blockdevice/util"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/platform"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
@@ -25,6 +29,9 @@ type State struct {
 // MachineState represents the machine's state.
 type MachineState struct {
 	disk *probe.ProbedBlockDevice
+
+	stagedMu sync.Mutex
+	staged   *machine.UpgradeStatus
 }
 
 // ClusterState represents the cluster's state.
@@ -36,7 +43,7 @@ type ClusterState struct {
 func NewState() (s *State, err error) {
 	var dev *probe.ProbedBlockDevice
 
-	dev, err = probe.GetDevWithFileSystemLabel(constants.EphemeralPartitionLabel)
+	dev, err = probeSystemDisk()
 	if err != nil {
 		if !errors.Is(err, os.ErrNotExist) {
 			return nil, err
@@ -85,7 +92,7 @@ func (s *MachineState) Disk() *probe.ProbedBlockDevice {
 	if s.disk == nil {
 		var dev *probe.ProbedBlockDevice
 
-		dev, err := probe.GetDevWithFileSystemLabel(constants.EphemeralPartitionLabel)
+		dev, err := probeSystemDisk()
 		if err == nil {
 			s.disk = dev
 		}
@@ -108,7 +115,7 @@ func (s *MachineState) Installed() bool {
 	if s.disk == nil {
 		var dev *probe.ProbedBlockDevice
 
-		dev, err := probe.GetDevWithFileSystemLabel(constants.EphemeralPartitionLabel)
+		dev, err := probeSystemDisk()
 		if err == nil {
 			s.disk = dev
 		}
@@ -116,3 +123,49 @@ func (s *MachineState) Installed() bool {
 
 	return s.disk != nil
 }
+
+// StagedUpgrade implements the machine state interface.
+func (s *MachineState) StagedUpgrade() *machine.UpgradeStatus {
+	s.stagedMu.Lock()
+	defer s.stagedMu.Unlock()
+
+	return s.staged
+}
+
+// SetStagedUpgrade implements the machine state interface.
+func (s *MachineState) SetStagedUpgrade(status *machine.UpgradeStatus) {
+	s.stagedMu.Lock()
+	defer s.stagedMu.Unlock()
+
+	s.staged = status
+}
+
+// probeSystemDisk finds the EPHEMERAL partition of the system disk. An
+// encrypted partition has no visible filesystem label, so the partition is
+// then looked up by its name.
+func probeSystemDisk() (*probe.ProbedBlockDevice, error) {
+	dev, err := probe.GetDevWithFileSystemLabel(constants.EphemeralPartitionLabel)
+	if err == nil || !errors.Is(err, os.ErrNotExist) {
+		return dev, err
+	}
+
+	f, partErr := probe.GetPartitionWithName(constants.EphemeralPartitionLabel)
+	if partErr != nil {
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer f.Close()
+
+	devname, err := util.DevnameFromPartname(f.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	bd, err := blockdevice.Open("/dev/" + devname)
+	if err != nil {
+		return nil, err
+	}
+
+	return &probe.ProbedBlockDevice{BlockDevice: bd, Path: f.Name()}, nil
+}
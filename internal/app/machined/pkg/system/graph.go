@@ -0,0 +1,153 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package system
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// dependencyGraph maps service ID to the list of service IDs it depends on.
+type dependencyGraph map[string][]string
+
+// findCycle returns the first dependency cycle found in the graph.
+//
+// Cycle is returned as a list of service IDs, with the first service
+// repeated at the end, e.g. [a b c a]. Dependencies on services which are
+// not part of the graph are ignored.
+func (graph dependencyGraph) findCycle() []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	color := make(map[string]int, len(graph))
+	path := []string{}
+
+	var visit func(id string) []string
+
+	visit = func(id string) []string {
+		color[id] = visiting
+
+		path = append(path, id)
+
+		for _, dep := range graph[id] {
+			if _, exists := graph[dep]; !exists {
+				continue
+			}
+
+			switch color[dep] {
+			case visiting:
+				for i := range path {
+					if path[i] == dep {
+						return append(append([]string(nil), path[i:]...), dep)
+					}
+				}
+			case unvisited:
+				if cycle := visit(dep); cycle != nil {
+					return cycle
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		color[id] = visited
+
+		return nil
+	}
+
+	for _, id := range graph.ids() {
+		if color[id] == unvisited {
+			if cycle := visit(id); cycle != nil {
+				return cycle
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkCycles returns an error if the graph contains a dependency cycle.
+func (graph dependencyGraph) checkCycles() error {
+	if cycle := graph.findCycle(); cycle != nil {
+		return fmt.Errorf("service dependency cycle detected: %s", strings.Join(cycle, " -> "))
+	}
+
+	return nil
+}
+
+// topologicalSort returns service IDs so that every service comes after all its dependencies.
+//
+// Services which don't depend on each other are sorted by ID to get stable order.
+// Graph should be checked for cycles before calling topologicalSort.
+func (graph dependencyGraph) topologicalSort() []string {
+	inDegree := make(map[string]int, len(graph))
+	dependents := graph.reverse()
+
+	for id, deps := range graph {
+		for _, dep := range deps {
+			if _, exists := graph[dep]; exists {
+				inDegree[id]++
+			}
+		}
+	}
+
+	ready := []string{}
+
+	for _, id := range graph.ids() {
+		if inDegree[id] == 0 {
+			ready = append(ready, id)
+		}
+	}
+
+	result := make([]string, 0, len(graph))
+
+	for len(ready) > 0 {
+		id := ready[0]
+		ready = ready[1:]
+
+		result = append(result, id)
+
+		for _, dependent := range dependents[id] {
+			inDegree[dependent]--
+
+			if inDegree[dependent] == 0 {
+				ready = append(ready, dependent)
+				sort.Strings(ready)
+			}
+		}
+	}
+
+	return result
+}
+
+// reverse returns a map of service ID to the sorted list of service IDs which depend on it.
+func (graph dependencyGraph) reverse() map[string][]string {
+	dependents := make(map[string][]string, len(graph))
+
+	for _, id := range graph.ids() {
+		for _, dep := range graph[id] {
+			if _, exists := graph[dep]; exists {
+				dependents[dep] = append(dependents[dep], id)
+			}
+		}
+	}
+
+	return dependents
+}
+
+func (graph dependencyGraph) ids() []string {
+	ids := make([]string, 0, len(graph))
+
+	for id := range graph {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	return ids
+}
@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package system
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDependencyGraphFindCycle(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		graph    dependencyGraph
+		expected []string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "no cycle",
+			graph: dependencyGraph{
+				"containerd": nil,
+				"cri":        {"containerd"},
+				"kubelet":    {"cri", "networkd"},
+				"networkd":   nil,
+			},
+		},
+		{
+			name: "missing dependency",
+			graph: dependencyGraph{
+				"kubelet": {"cri"},
+			},
+		},
+		{
+			name: "self",
+			graph: dependencyGraph{
+				"a": {"a"},
+			},
+			expected: []string{"a", "a"},
+		},
+		{
+			name: "cycle",
+			graph: dependencyGraph{
+				"a": {"b"},
+				"b": {"c"},
+				"c": {"d", "b"},
+				"d": nil,
+			},
+			expected: []string{"b", "c", "b"},
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.graph.findCycle())
+		})
+	}
+}
+
+func TestDependencyGraphCheckCycles(t *testing.T) {
+	assert.NoError(t, dependencyGraph{"a": nil, "b": {"a"}}.checkCycles())
+	assert.EqualError(t, dependencyGraph{"a": {"b"}, "b": {"a"}}.checkCycles(), "service dependency cycle detected: a -> b -> a")
+}
+
+func TestDependencyGraphTopologicalSort(t *testing.T) {
+	graph := dependencyGraph{
+		"apid":       {"containerd"},
+		"containerd": nil,
+		"cri":        {"containerd"},
+		"etcd":       {"cri", "networkd"},
+		"kubelet":    {"cri", "networkd", "udevd"},
+		"networkd":   nil,
+		"udevd":      {"missing"},
+	}
+
+	assert.Equal(t, []string{"containerd", "apid", "cri", "networkd", "etcd", "udevd", "kubelet"}, graph.topologicalSort())
+
+	assert.Equal(t, map[string][]string{
+		"containerd": {"apid", "cri"},
+		"cri":        {"etcd", "kubelet"},
+		"networkd":   {"etcd", "kubelet"},
+		"udevd":      {"kubelet"},
+	}, graph.reverse())
+}
@@ -51,6 +51,8 @@ func Run(ctx context.Context, settings *Settings, state *State, check Check) err
 
 		if !healthy {
 			message = err.Error()
+		} else if settings.HealthyMessage != nil {
+			message = settings.HealthyMessage()
 		}
 
 		state.Update(healthy, message)
@@ -135,6 +135,65 @@ func (suite *CheckSuite) TestHealthChange() {
 	suite.Assert().True(*change.New.Healthy)
 }
 
+func (suite *CheckSuite) TestHealthyMessage() {
+	settings := health.Settings{
+		InitialDelay: time.Millisecond,
+		Period:       time.Millisecond,
+		Timeout:      time.Millisecond,
+		HealthyMessage: func() string {
+			return "last run succeeded"
+		},
+	}
+
+	var healthy uint32
+
+	check := func(context.Context) error {
+		if atomic.LoadUint32(&healthy) == 1 {
+			return nil
+		}
+
+		return errors.New("health failed")
+	}
+
+	var state health.State
+
+	errCh := make(chan error)
+	ctx, ctxCancel := context.WithCancel(context.Background())
+
+	go func() {
+		errCh <- health.Run(ctx, &settings, &state, check)
+	}()
+
+	for i := 0; i < 20; i++ {
+		if state.Get().Healthy != nil {
+			break
+		}
+
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	// the healthy message is not reported when the check fails
+	suite.Require().False(*state.Get().Healthy)
+	suite.Require().Equal("health failed", state.Get().LastMessage)
+
+	atomic.StoreUint32(&healthy, 1)
+
+	for i := 0; i < 10; i++ {
+		time.Sleep(20 * time.Millisecond)
+
+		if *state.Get().Healthy {
+			break
+		}
+	}
+
+	suite.Require().True(*state.Get().Healthy)
+	suite.Require().Equal("last run succeeded", state.Get().LastMessage)
+
+	ctxCancel()
+
+	suite.Assert().EqualError(<-errCh, context.Canceled.Error())
+}
+
 func (suite *CheckSuite) TestCheckAbort() {
 	settings := health.Settings{
 		InitialDelay: time.Millisecond,
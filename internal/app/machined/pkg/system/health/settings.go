@@ -13,6 +13,10 @@ type Settings struct {
 	InitialDelay time.Duration
 	Period       time.Duration
 	Timeout      time.Duration
+
+	// HealthyMessage, if set, provides the message reported while the
+	// service is healthy, e.g. the time of the last successful run.
+	HealthyMessage func() string
 }
 
 // DefaultSettings provides some default health check settings.
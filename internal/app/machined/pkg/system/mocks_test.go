@@ -64,6 +64,16 @@ func (m *MockService) DependsOn(runtime.Runtime) []string {
 	return m.dependencies
 }
 
+type MockShutdownTimeoutService struct {
+	MockService
+
+	shutdownTimeout time.Duration
+}
+
+func (m *MockShutdownTimeoutService) ShutdownTimeout(runtime.Runtime) time.Duration {
+	return m.shutdownTimeout
+}
+
 type MockHealthcheckedService struct {
 	MockService
 
@@ -98,6 +108,7 @@ func (m *MockHealthcheckedService) HealthSettings(runtime.Runtime) *health.Setti
 
 type MockRunner struct {
 	exitCh chan error
+	onStop func()
 }
 
 func (m *MockRunner) Open(ctx context.Context) error {
@@ -114,6 +125,10 @@ func (m *MockRunner) Run(eventSink events.Recorder) error {
 }
 
 func (m *MockRunner) Stop() error {
+	if m.onStop != nil {
+		m.onStop()
+	}
+
 	close(m.exitCh)
 
 	return nil
@@ -6,16 +6,20 @@ package containerd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"sync"
 	"syscall"
 	"time"
 
+	v1 "github.com/containerd/cgroups/stats/v1"
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/cio"
 	"github.com/containerd/containerd/namespaces"
 	"github.com/containerd/containerd/oci"
+	"github.com/containerd/typeurl"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
@@ -34,6 +38,10 @@ type containerdRunner struct {
 	ctx         context.Context
 	container   containerd.Container
 	stdinCloser *stdinCloser
+
+	statsMu  sync.Mutex
+	task     containerd.Task
+	exitCode int
 }
 
 // NewRunner creates runner.Runner that runs a container in containerd.
@@ -168,6 +176,9 @@ func (c *containerdRunner) Run(eventSink events.Recorder) error {
 		return fmt.Errorf("failed to start task: %q: %w", c.args.ID, err)
 	}
 
+	c.setTask(task)
+	defer c.setTask(nil)
+
 	eventSink(events.StateRunning, "Started task %s (PID %d) for container %s", task.ID(), task.Pid(), c.container.ID())
 
 	statusC, err := task.Wait(c.ctx)
@@ -178,6 +189,8 @@ func (c *containerdRunner) Run(eventSink events.Recorder) error {
 	select {
 	case status := <-statusC:
 		code := status.ExitCode()
+		c.setExitCode(int(code))
+
 		if code != 0 {
 			return fmt.Errorf("task %q failed: exit code %d", c.args.ID, code)
 		}
@@ -193,8 +206,10 @@ func (c *containerdRunner) Run(eventSink events.Recorder) error {
 	}
 
 	select {
-	case <-statusC:
+	case status := <-statusC:
 		// stopped process exited
+		c.setExitCode(int(status.ExitCode()))
+
 		return nil
 	case <-time.After(c.opts.GracefulShutdownTimeout):
 		// kill the process
@@ -205,7 +220,8 @@ func (c *containerdRunner) Run(eventSink events.Recorder) error {
 		}
 	}
 
-	<-statusC
+	status := <-statusC
+	c.setExitCode(int(status.ExitCode()))
 
 	return logW.Close()
 }
@@ -222,6 +238,55 @@ func (c *containerdRunner) Stop() error {
 	return nil
 }
 
+// Stats implements runner.StatsReporter interface.
+func (c *containerdRunner) Stats() (runner.Stats, error) {
+	c.statsMu.Lock()
+	task := c.task
+	stats := runner.Stats{
+		ExitCode: c.exitCode,
+	}
+	c.statsMu.Unlock()
+
+	if task == nil {
+		return stats, nil
+	}
+
+	stats.PID = int(task.Pid())
+
+	metrics, err := task.Metrics(c.ctx)
+	if err != nil {
+		return stats, fmt.Errorf("error pulling metrics for %q: %w", c.args.ID, err)
+	}
+
+	anydata, err := typeurl.UnmarshalAny(metrics.Data)
+	if err != nil {
+		return stats, fmt.Errorf("error unmarshalling metrics for %q: %w", c.args.ID, err)
+	}
+
+	data, ok := anydata.(*v1.Metrics)
+	if !ok {
+		return stats, errors.New("failed to convert metric data to v1.Metrics")
+	}
+
+	stats.SetUsage(data)
+
+	return stats, nil
+}
+
+func (c *containerdRunner) setTask(task containerd.Task) {
+	c.statsMu.Lock()
+	defer c.statsMu.Unlock()
+
+	c.task = task
+}
+
+func (c *containerdRunner) setExitCode(code int) {
+	c.statsMu.Lock()
+	defer c.statsMu.Unlock()
+
+	c.exitCode = code
+}
+
 func (c *containerdRunner) newContainerOpts(image containerd.Image, specOpts []oci.SpecOpts) []containerd.NewContainerOpts {
 	containerOpts := []containerd.NewContainerOpts{
 		containerd.WithImage(image),
@@ -0,0 +1,104 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package process
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+
+	"github.com/containerd/cgroups"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// start starts the process in the cgroup of the service, so that the usage of
+// the process and of its children is accounted from the start.
+//
+// The process inherits the cgroup of the forking thread, so the thread is
+// moved to the cgroup for the duration of the fork. The thread is terminated
+// if it can't be moved back. The process is started outside of the cgroup if
+// the cgroup can't be set up.
+func (p *processRunner) start(cmd *exec.Cmd) (cgroups.Cgroup, error) {
+	type result struct {
+		cgroup cgroups.Cgroup
+		err    error
+	}
+
+	resultCh := make(chan result, 1)
+
+	go func() {
+		runtime.LockOSThread()
+
+		cgroup, err := p.enterCgroup()
+		if err != nil {
+			runtime.UnlockOSThread()
+
+			// the usage of the child processes is not reported
+			log.Printf("failed to set up cgroup of %s: %s", p, err)
+
+			resultCh <- result{err: cmd.Start()}
+
+			return
+		}
+
+		err = cmd.Start()
+
+		if e := leaveCgroup(); e != nil {
+			log.Printf("failed to move thread out of cgroup of %s: %s", p, e)
+		} else {
+			runtime.UnlockOSThread()
+		}
+
+		if err != nil {
+			cgroup.Delete() //nolint: errcheck
+
+			cgroup = nil
+		}
+
+		resultCh <- result{cgroup: cgroup, err: err}
+	}()
+
+	res := <-resultCh
+
+	return res.cgroup, res.err
+}
+
+// enterCgroup creates the cgroup of the service and moves the calling thread
+// to it.
+func (p *processRunner) enterCgroup() (cgroups.Cgroup, error) {
+	path := p.opts.CgroupPath
+	if path == "" {
+		path = filepath.Join(constants.SystemProcessesCgroup, p.args.ID)
+	}
+
+	cgroup, err := cgroups.New(cgroups.V1, cgroups.StaticPath(path), &specs.LinuxResources{})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = cgroup.AddTask(cgroups.Process{Pid: unix.Gettid()}); err != nil {
+		cgroup.Delete() //nolint: errcheck
+
+		return nil, err
+	}
+
+	return cgroup, nil
+}
+
+// leaveCgroup moves the calling thread back to the cgroup of the process.
+func leaveCgroup() error {
+	cgroup, err := cgroups.Load(cgroups.V1, cgroups.PidPath(os.Getpid()))
+	if err != nil {
+		return fmt.Errorf("error loading cgroup: %w", err)
+	}
+
+	return cgroup.AddTask(cgroups.Process{Pid: unix.Gettid()})
+}
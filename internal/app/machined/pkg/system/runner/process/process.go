@@ -8,11 +8,16 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"log"
 	"os"
 	"os/exec"
+	"sync"
 	"syscall"
 	"time"
 
+	"github.com/containerd/cgroups"
+	"github.com/prometheus/procfs"
+
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
@@ -27,6 +32,10 @@ type processRunner struct {
 
 	stop    chan struct{}
 	stopped chan struct{}
+
+	statsMu sync.Mutex
+	stats   runner.Stats
+	cgroup  cgroups.Cgroup
 }
 
 // NewRunner creates runner.Runner that runs a process on the host.
@@ -75,12 +84,91 @@ func (p *processRunner) Close() error {
 	return nil
 }
 
+// Stats implements the runner.StatsReporter interface.
+//
+// The usage is read from the cgroup of the process, so that the usage of the
+// child processes is counted as well.
+func (p *processRunner) Stats() (runner.Stats, error) {
+	p.statsMu.Lock()
+	stats := p.stats
+	cgroup := p.cgroup
+	p.statsMu.Unlock()
+
+	if stats.PID == 0 {
+		return stats, nil
+	}
+
+	if cgroup == nil {
+		return procStats(stats)
+	}
+
+	metrics, err := cgroup.Stat(cgroups.IgnoreNotExist)
+	if err != nil {
+		return stats, fmt.Errorf("error reading cgroup stats for %q: %w", p.args.ID, err)
+	}
+
+	stats.SetUsage(metrics)
+
+	return stats, nil
+}
+
+// procStats reads the usage of the main process only, if the cgroups are not
+// available.
+func procStats(stats runner.Stats) (runner.Stats, error) {
+	proc, err := procfs.NewProc(stats.PID)
+	if err != nil {
+		return stats, err
+	}
+
+	procStat, err := proc.Stat()
+	if err != nil {
+		return stats, err
+	}
+
+	stats.CPUUsage = time.Duration(procStat.CPUTime() * float64(time.Second))
+	stats.MemoryUsage = uint64(procStat.ResidentMemory())
+
+	return stats, nil
+}
+
+func (p *processRunner) setPID(pid int, cgroup cgroups.Cgroup) {
+	p.statsMu.Lock()
+	defer p.statsMu.Unlock()
+
+	p.stats.PID = pid
+	p.cgroup = cgroup
+}
+
+func (p *processRunner) setExitStatus(status syscall.WaitStatus) {
+	p.statsMu.Lock()
+	defer p.statsMu.Unlock()
+
+	p.stats.PID = 0
+	p.cgroup = nil
+
+	switch {
+	case status.Signaled():
+		p.stats.ExitCode = -1
+		p.stats.ExitSignal = status.Signal()
+	case status.Exited():
+		p.stats.ExitCode = status.ExitStatus()
+		p.stats.ExitSignal = 0
+	}
+}
+
 func (p *processRunner) build() (cmd *exec.Cmd, logCloser io.Closer, err error) {
 	cmd = exec.Command(p.args.ProcessArgs[0], p.args.ProcessArgs[1:]...)
 
 	// Set the environment for the service.
 	cmd.Env = append([]string{fmt.Sprintf("PATH=%s", constants.PATH)}, p.opts.Env...)
 
+	if p.opts.Sandbox.Enabled() {
+		if err = sandboxCommand(cmd, p.opts.Sandbox); err != nil {
+			err = fmt.Errorf("error setting up sandbox: %w", err)
+			return
+		}
+	}
+
 	// Setup logging.
 	w, err := p.opts.LoggingManager.ServiceLog(p.args.ID).Writer()
 	if err != nil {
@@ -116,16 +204,31 @@ func (p *processRunner) run(eventSink events.Recorder) error {
 		defer reaper.Stop(notifyCh)
 	}
 
-	if err = cmd.Start(); err != nil {
+	cgroup, err := p.start(cmd)
+	if err != nil {
 		return fmt.Errorf("error starting process: %w", err)
 	}
 
+	if cgroup != nil {
+		defer func() {
+			if e := cgroup.Delete(); e != nil {
+				log.Printf("failed to delete cgroup of %s: %s", p, e)
+			}
+		}()
+	}
+
+	p.setPID(cmd.Process.Pid, cgroup)
+
 	eventSink(events.StateRunning, "Process %s started with PID %d", p, cmd.Process.Pid)
 
 	waitCh := make(chan error)
 
 	go func() {
-		waitCh <- reaper.WaitWrapper(usingReaper, notifyCh, cmd)
+		status, err := reaper.WaitStatusWrapper(usingReaper, notifyCh, cmd)
+
+		p.setExitStatus(status)
+
+		waitCh <- err
 	}()
 
 	select {
@@ -11,11 +11,16 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"sync"
+	"syscall"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/syndtr/gocapability/capability"
+	"golang.org/x/sys/unix"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
@@ -99,6 +104,93 @@ func (suite *ProcessSuite) TestRunLogs() {
 	suite.Assert().Equal([]byte("Test 1\nTest 2\n"), logContents)
 }
 
+func (suite *ProcessSuite) TestStats() {
+	r := process.NewRunner(false, &runner.Args{
+		ID:          "stats",
+		ProcessArgs: []string{"/bin/sh", "-c", "sleep 0.5; exit 3"},
+	}, runner.WithLoggingManager(suite.loggingManager))
+
+	suite.Assert().NoError(r.Open(context.Background()))
+
+	defer func() { suite.Assert().NoError(r.Close()) }()
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- r.Run(MockEventSink)
+	}()
+
+	reporter, ok := r.(runner.StatsReporter)
+	suite.Require().True(ok)
+
+	var (
+		stats runner.Stats
+		err   error
+	)
+
+	for i := 0; i < 20; i++ {
+		stats, err = reporter.Stats()
+		suite.Require().NoError(err)
+
+		if stats.PID != 0 {
+			break
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	suite.Assert().NotZero(stats.PID)
+	suite.Assert().NotZero(stats.MemoryUsage)
+
+	suite.Assert().EqualError(<-done, "exit status 3")
+
+	stats, err = reporter.Stats()
+	suite.Require().NoError(err)
+
+	suite.Assert().Zero(stats.PID)
+	suite.Assert().Equal(3, stats.ExitCode)
+	suite.Assert().Zero(stats.ExitSignal)
+}
+
+func (suite *ProcessSuite) TestStatsChildren() {
+	if os.Getuid() != 0 {
+		suite.T().Skip("cgroup tests require root")
+	}
+
+	// the main process sleeps while its child keeps the CPU busy
+	r := process.NewRunner(false, &runner.Args{
+		ID:          "stats-children",
+		ProcessArgs: []string{"/bin/sh", "-c", "sleep 0.1; (while :; do :; done) & sleep 1; kill $!; wait"},
+	},
+		runner.WithLoggingManager(suite.loggingManager),
+		runner.WithCgroupPath("/talos-test-stats-children"),
+	)
+
+	suite.Assert().NoError(r.Open(context.Background()))
+
+	defer func() { suite.Assert().NoError(r.Close()) }()
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- r.Run(MockEventSink)
+	}()
+
+	time.Sleep(800 * time.Millisecond)
+
+	stats, err := r.(runner.StatsReporter).Stats()
+	suite.Require().NoError(err)
+
+	suite.Assert().NotZero(stats.PID)
+	suite.Assert().Greater(int64(stats.CPUUsage), int64(300*time.Millisecond))
+	suite.Assert().NotZero(stats.MemoryUsage)
+
+	suite.Assert().NoError(<-done)
+
+	_, err = os.Stat("/sys/fs/cgroup/cpuacct/talos-test-stats-children")
+	suite.Assert().True(os.IsNotExist(err))
+}
+
 func (suite *ProcessSuite) TestRunRestartFailed() {
 	testFile := filepath.Join(suite.tmpDir, "talos-test")
 	// nolint: errcheck
@@ -223,6 +315,123 @@ func (suite *ProcessSuite) TestStopSigKill() {
 	<-done
 }
 
+func (suite *ProcessSuite) runSandboxed(id, script string, setters ...runner.Option) string {
+	if os.Getuid() != 0 {
+		suite.T().Skip("sandbox tests require root")
+	}
+
+	r := process.NewRunner(false, &runner.Args{
+		ID:          id,
+		ProcessArgs: []string{"/bin/sh", "-c", script},
+	}, append([]runner.Option{runner.WithLoggingManager(suite.loggingManager)}, setters...)...)
+
+	suite.Require().NoError(r.Open(context.Background()))
+
+	defer func() { suite.Assert().NoError(r.Close()) }()
+
+	suite.Require().NoError(r.Run(MockEventSink))
+
+	logContents, err := ioutil.ReadFile(filepath.Join(suite.tmpDir, id+".log"))
+	suite.Require().NoError(err)
+
+	return string(logContents)
+}
+
+func (suite *ProcessSuite) TestSandboxPrivileges() {
+	output := suite.runSandboxed("sandbox-privs", "grep -E '^(CapBnd|CapEff|NoNewPrivs|Seccomp):' /proc/self/status",
+		runner.WithDroppedCapabilities(capability.CAP_SYS_BOOT, capability.CAP_SYS_MODULE),
+		runner.WithNoNewPrivileges(),
+		runner.WithSeccompDeniedSyscalls(unix.SYS_REBOOT, unix.SYS_KEXEC_LOAD),
+	)
+
+	status := map[string]string{}
+
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		parts := strings.SplitN(line, ":", 2)
+		suite.Require().Len(parts, 2, "unexpected line %q", line)
+
+		status[parts[0]] = strings.TrimSpace(parts[1])
+	}
+
+	suite.Assert().Equal("1", status["NoNewPrivs"])
+	suite.Assert().Equal("2", status["Seccomp"])
+
+	for _, set := range []string{"CapBnd", "CapEff"} {
+		mask, err := strconv.ParseUint(status[set], 16, 64)
+		suite.Require().NoError(err)
+
+		suite.Assert().Zero(mask&(1<<uint(capability.CAP_SYS_BOOT)), "%s: %s", set, status[set])
+		suite.Assert().Zero(mask&(1<<uint(capability.CAP_SYS_MODULE)), "%s: %s", set, status[set])
+		suite.Assert().NotZero(mask&(1<<uint(capability.CAP_SYS_ADMIN)), "%s: %s", set, status[set])
+	}
+}
+
+func (suite *ProcessSuite) TestSandboxSeccomp() {
+	output := suite.runSandboxed("sandbox-seccomp", "kill -0 $$ && echo allowed; uname 2>&1 || echo denied",
+		runner.WithSeccompDeniedSyscalls(unix.SYS_UNAME),
+	)
+
+	suite.Assert().Contains(output, "allowed")
+	suite.Assert().Contains(output, "Operation not permitted")
+	suite.Assert().Contains(output, "denied")
+}
+
+func (suite *ProcessSuite) TestSandboxNamespaces() {
+	hostUTS, err := os.Readlink("/proc/self/ns/uts")
+	suite.Require().NoError(err)
+
+	// the read-only remount covers only the root mount, so the file is created
+	// in the temporary directory only if it's on the root filesystem
+	var rootStat, tmpStat syscall.Stat_t
+
+	suite.Require().NoError(syscall.Stat("/", &rootStat))
+	suite.Require().NoError(syscall.Stat(suite.tmpDir, &tmpStat))
+
+	onRootfs := rootStat.Dev == tmpStat.Dev
+
+	testFile := filepath.Join(suite.tmpDir, "sandbox-ns-test")
+
+	output := suite.runSandboxed("sandbox-ns", "echo pid=$$; echo uts=$(readlink /proc/self/ns/uts); touch "+testFile+" || echo read-only",
+		runner.WithNamespaces(syscall.CLONE_NEWPID|syscall.CLONE_NEWUTS),
+		runner.WithReadonlyRootfs(),
+	)
+
+	suite.Assert().Contains(output, "pid=1\n")
+	suite.Assert().Contains(output, "uts=uts:")
+	suite.Assert().NotContains(output, "uts="+hostUTS)
+
+	if onRootfs {
+		suite.Assert().Contains(output, "read-only")
+
+		_, err = os.Stat(testFile)
+		suite.Assert().True(os.IsNotExist(err))
+	}
+
+	// mount namespace is private, the filesystem stays writable for the host
+	suite.Require().NoError(ioutil.WriteFile(testFile, nil, 0o600))
+}
+
+func (suite *ProcessSuite) TestSandboxCredential() {
+	output := suite.runSandboxed("sandbox-cred", "echo uid=$(id -u) gid=$(id -g)",
+		runner.WithCredential(65534, 65534),
+	)
+
+	suite.Assert().Equal("uid=65534 gid=65534\n", output)
+}
+
+func (suite *ProcessSuite) TestSandboxUnsupportedNamespace() {
+	r := process.NewRunner(false, &runner.Args{
+		ID:          "sandbox-netns",
+		ProcessArgs: []string{"/bin/sh", "-c", "exit 0"},
+	}, runner.WithLoggingManager(suite.loggingManager), runner.WithNamespaces(syscall.CLONE_NEWNET))
+
+	suite.Require().NoError(r.Open(context.Background()))
+
+	defer func() { suite.Assert().NoError(r.Close()) }()
+
+	suite.Assert().EqualError(r.Run(MockEventSink), "error building command: error setting up sandbox: unsupported namespaces 0x40000000")
+}
+
 func TestProcessSuite(t *testing.T) {
 	for _, runReaper := range []bool{true, false} {
 		func(runReaper bool) {
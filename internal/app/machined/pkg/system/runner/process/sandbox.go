@@ -0,0 +1,273 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package process
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"syscall"
+	"unsafe"
+
+	"github.com/syndtr/gocapability/capability"
+	"golang.org/x/net/bpf"
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
+)
+
+const (
+	// sandboxLauncher is argv[0] used to re-execute current binary as a launcher
+	// which applies the sandbox and executes the actual process.
+	sandboxLauncher = "talos-sandbox-launcher"
+	// sandboxEnv passes the sandbox configuration to the launcher.
+	sandboxEnv = "TALOS_SANDBOX"
+
+	supportedNamespaces = syscall.CLONE_NEWNS | syscall.CLONE_NEWPID | syscall.CLONE_NEWUTS
+
+	// maxSeccompSyscalls keeps jump offsets in the seccomp filter within uint8.
+	maxSeccompSyscalls = 250
+)
+
+// Seccomp filter constants from linux/seccomp.h and linux/audit.h.
+const (
+	seccompRetAllow = 0x7fff0000
+	seccompRetErrno = 0x00050000
+
+	seccompDataNrOffset   = 0
+	seccompDataArchOffset = 4
+)
+
+func init() {
+	if len(os.Args) < 3 || os.Args[0] != sandboxLauncher {
+		return
+	}
+
+	// restrictions are applied to the calling thread, so the process should be
+	// executed from the same OS thread
+	runtime.LockOSThread()
+
+	if err := launch(); err != nil {
+		fmt.Fprintf(os.Stderr, "error launching sandboxed process: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+// sandboxCommand wraps the command to be launched via the sandbox launcher.
+func sandboxCommand(cmd *exec.Cmd, sandbox runner.Sandbox) error {
+	if sandbox.ReadonlyRootfs {
+		sandbox.Namespaces |= syscall.CLONE_NEWNS
+	}
+
+	if sandbox.Namespaces&^supportedNamespaces != 0 {
+		return fmt.Errorf("unsupported namespaces %#x", sandbox.Namespaces&^supportedNamespaces)
+	}
+
+	if len(sandbox.SeccompDeniedSyscalls) > maxSeccompSyscalls {
+		return fmt.Errorf("too many syscalls in seccomp filter: %d > %d", len(sandbox.SeccompDeniedSyscalls), maxSeccompSyscalls)
+	}
+
+	config, err := json.Marshal(sandbox)
+	if err != nil {
+		return err
+	}
+
+	self, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("error locating sandbox launcher: %w", err)
+	}
+
+	cmd.Args = append([]string{sandboxLauncher, cmd.Path}, cmd.Args...)
+	cmd.Path = self
+	cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", sandboxEnv, config))
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Cloneflags: sandbox.Namespaces,
+	}
+
+	return nil
+}
+
+func launch() error {
+	var sandbox runner.Sandbox
+
+	env := make([]string, 0, len(os.Environ()))
+
+	for _, e := range os.Environ() {
+		if strings.HasPrefix(e, sandboxEnv+"=") {
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(e, sandboxEnv+"=")), &sandbox); err != nil {
+				return fmt.Errorf("error decoding sandbox config: %w", err)
+			}
+
+			continue
+		}
+
+		env = append(env, e)
+	}
+
+	if err := applySandbox(&sandbox); err != nil {
+		return err
+	}
+
+	return unix.Exec(os.Args[1], os.Args[2:], env)
+}
+
+// applySandbox applies restrictions to the current thread.
+//
+// Order is important: mounts and capabilities require privileges which might be
+// dropped at later stages.
+//
+//nolint: gocyclo
+func applySandbox(sandbox *runner.Sandbox) error {
+	if sandbox.Namespaces&syscall.CLONE_NEWNS != 0 {
+		// don't propagate mount changes back to the host
+		if err := unix.Mount("", "/", "", unix.MS_REC|unix.MS_PRIVATE, ""); err != nil {
+			return fmt.Errorf("error making mounts private: %w", err)
+		}
+
+		if sandbox.Namespaces&syscall.CLONE_NEWPID != 0 {
+			if err := unix.Mount("proc", "/proc", "proc", unix.MS_NOSUID|unix.MS_NODEV|unix.MS_NOEXEC, ""); err != nil {
+				return fmt.Errorf("error mounting /proc: %w", err)
+			}
+		}
+
+		if sandbox.ReadonlyRootfs {
+			if err := unix.Mount("", "/", "", unix.MS_REMOUNT|unix.MS_BIND|unix.MS_RDONLY, ""); err != nil {
+				return fmt.Errorf("error remounting root read-only: %w", err)
+			}
+		}
+	}
+
+	if len(sandbox.DropCapabilities) > 0 {
+		caps, err := capability.NewPid2(0)
+		if err != nil {
+			return err
+		}
+
+		if err = caps.Load(); err != nil {
+			return fmt.Errorf("error loading capabilities: %w", err)
+		}
+
+		caps.Unset(capability.CAPS|capability.BOUNDS, sandbox.DropCapabilities...)
+
+		if err = caps.Apply(capability.CAPS | capability.BOUNDS); err != nil {
+			return fmt.Errorf("error dropping capabilities: %w", err)
+		}
+	}
+
+	if sandbox.NoNewPrivileges {
+		if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+			return fmt.Errorf("error setting no_new_privs: %w", err)
+		}
+	}
+
+	if len(sandbox.SeccompDeniedSyscalls) > 0 {
+		if err := loadSeccompFilter(sandbox.SeccompDeniedSyscalls); err != nil {
+			return fmt.Errorf("error loading seccomp filter: %w", err)
+		}
+	}
+
+	if sandbox.Credential != nil {
+		if err := setCredential(sandbox.Credential); err != nil {
+			return fmt.Errorf("error setting credentials: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// seccompFilter builds a BPF program which denies listed syscalls with EPERM.
+//
+// Syscalls made with a foreign architecture calling convention are denied as well,
+// including the x32 ABI syscalls on amd64 which share the architecture with the
+// native ones.
+func seccompFilter(denied []uintptr) ([]bpf.RawInstruction, error) {
+	if auditArch == 0 {
+		return nil, errors.New("seccomp is not supported on " + runtime.GOARCH)
+	}
+
+	n := len(denied)
+
+	prog := []bpf.Instruction{
+		bpf.LoadAbsolute{Off: seccompDataArchOffset, Size: 4},
+	}
+
+	if syscallNrLimit != 0 {
+		prog = append(prog,
+			bpf.JumpIf{Cond: bpf.JumpEqual, Val: auditArch, SkipFalse: uint8(n + 3)},
+			bpf.LoadAbsolute{Off: seccompDataNrOffset, Size: 4},
+			bpf.JumpIf{Cond: bpf.JumpGreaterOrEqual, Val: syscallNrLimit, SkipTrue: uint8(n + 1)},
+		)
+	} else {
+		prog = append(prog,
+			bpf.JumpIf{Cond: bpf.JumpEqual, Val: auditArch, SkipFalse: uint8(n + 2)},
+			bpf.LoadAbsolute{Off: seccompDataNrOffset, Size: 4},
+		)
+	}
+
+	for i, nr := range denied {
+		prog = append(prog, bpf.JumpIf{Cond: bpf.JumpEqual, Val: uint32(nr), SkipTrue: uint8(n - i)})
+	}
+
+	prog = append(prog,
+		bpf.RetConstant{Val: seccompRetAllow},
+		bpf.RetConstant{Val: seccompRetErrno | uint32(unix.EPERM)},
+	)
+
+	return bpf.Assemble(prog)
+}
+
+func loadSeccompFilter(denied []uintptr) error {
+	raw, err := seccompFilter(denied)
+	if err != nil {
+		return err
+	}
+
+	filter := make([]unix.SockFilter, len(raw))
+
+	for i, insn := range raw {
+		filter[i] = unix.SockFilter{
+			Code: insn.Op,
+			Jt:   insn.Jt,
+			Jf:   insn.Jf,
+			K:    insn.K,
+		}
+	}
+
+	prog := unix.SockFprog{
+		Len:    uint16(len(filter)),
+		Filter: &filter[0],
+	}
+
+	return unix.Prctl(unix.PR_SET_SECCOMP, unix.SECCOMP_MODE_FILTER, uintptr(unsafe.Pointer(&prog)), 0, 0)
+}
+
+// setCredential changes user and groups of the current thread.
+//
+// Raw syscalls are used to affect only the thread which executes the process.
+func setCredential(cred *syscall.Credential) error {
+	var groups unsafe.Pointer
+
+	if len(cred.Groups) > 0 {
+		groups = unsafe.Pointer(&cred.Groups[0])
+	}
+
+	if _, _, errno := unix.RawSyscall(unix.SYS_SETGROUPS, uintptr(len(cred.Groups)), uintptr(groups), 0); errno != 0 {
+		return fmt.Errorf("setgroups: %w", errno)
+	}
+
+	if _, _, errno := unix.RawSyscall(unix.SYS_SETGID, uintptr(cred.Gid), 0, 0); errno != 0 {
+		return fmt.Errorf("setgid: %w", errno)
+	}
+
+	if _, _, errno := unix.RawSyscall(unix.SYS_SETUID, uintptr(cred.Uid), 0, 0); errno != 0 {
+		return fmt.Errorf("setuid: %w", errno)
+	}
+
+	return nil
+}
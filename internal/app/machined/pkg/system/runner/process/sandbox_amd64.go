@@ -0,0 +1,12 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package process
+
+// auditArch is AUDIT_ARCH_X86_64.
+const auditArch = 0xc000003e
+
+// syscallNrLimit is __X32_SYSCALL_BIT: x32 ABI syscalls report AUDIT_ARCH_X86_64
+// with this bit set in the syscall number.
+const syscallNrLimit = 0x40000000
@@ -0,0 +1,50 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint: testpackage
+package process
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/bpf"
+	"golang.org/x/sys/unix"
+)
+
+func TestSeccompFilterX32(t *testing.T) {
+	raw, err := seccompFilter([]uintptr{unix.SYS_REBOOT})
+	require.NoError(t, err)
+
+	prog := make([]bpf.Instruction, len(raw))
+
+	for i, insn := range raw {
+		prog[i] = insn.Disassemble()
+	}
+
+	vm, err := bpf.NewVM(prog)
+	require.NoError(t, err)
+
+	run := func(arch, nr uint32) int {
+		// the VM loads the data in the network byte order
+		data := make([]byte, 64)
+		binary.BigEndian.PutUint32(data[seccompDataNrOffset:], nr)
+		binary.BigEndian.PutUint32(data[seccompDataArchOffset:], arch)
+
+		ret, err := vm.Run(data)
+		require.NoError(t, err)
+
+		return ret
+	}
+
+	denied := seccompRetErrno | int(unix.EPERM)
+
+	assert.Equal(t, seccompRetAllow, run(auditArch, unix.SYS_UNAME))
+	assert.Equal(t, denied, run(auditArch, unix.SYS_REBOOT))
+	assert.Equal(t, denied, run(auditArch, syscallNrLimit|unix.SYS_REBOOT))
+	assert.Equal(t, denied, run(auditArch, syscallNrLimit|unix.SYS_UNAME))
+	assert.Equal(t, denied, run(0x40000003, unix.SYS_UNAME))
+}
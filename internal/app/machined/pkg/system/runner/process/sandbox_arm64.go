@@ -2,13 +2,10 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-package check_test
+package process
 
-import "testing"
+// auditArch is AUDIT_ARCH_AARCH64.
+const auditArch = 0xc00000b7
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
-}
+// syscallNrLimit is not defined, all syscall numbers belong to the native ABI.
+const syscallNrLimit = 0
@@ -0,0 +1,13 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// +build !amd64,!arm64
+
+package process
+
+// auditArch is not defined, seccomp is not supported.
+const auditArch = 0
+
+// syscallNrLimit is not defined, all syscall numbers belong to the native ABI.
+const syscallNrLimit = 0
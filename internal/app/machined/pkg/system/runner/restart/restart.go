@@ -168,6 +168,15 @@ func (r *restarter) Close() error {
 	return r.wrappedRunner.Close()
 }
 
+// Stats implements the runner.StatsReporter interface.
+func (r *restarter) Stats() (runner.Stats, error) {
+	if reporter, ok := r.wrappedRunner.(runner.StatsReporter); ok {
+		return reporter.Stats()
+	}
+
+	return runner.Stats{}, nil
+}
+
 // String implements the Runner interface.
 func (r *restarter) String() string {
 	return fmt.Sprintf("Restart(%s, %s)", r.opts.Type, r.wrappedRunner)
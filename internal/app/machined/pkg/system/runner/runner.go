@@ -8,10 +8,13 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"syscall"
 	"time"
 
+	v1 "github.com/containerd/cgroups/stats/v1"
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/oci"
+	"github.com/syndtr/gocapability/capability"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
@@ -28,6 +31,40 @@ type Runner interface {
 	Close() error
 }
 
+// StatsReporter is implemented by runners which can report runtime statistics
+// of the process they run.
+type StatsReporter interface {
+	Stats() (Stats, error)
+}
+
+// Stats describes runtime statistics of the process managed by the runner.
+type Stats struct {
+	// PID of the running process, zero if the process is not running.
+	PID int
+	// ExitCode of the last finished run.
+	ExitCode int
+	// ExitSignal is the signal which terminated the last finished run, if any.
+	ExitSignal syscall.Signal
+	// CPUUsage is the total CPU time consumed by the running process and its
+	// children.
+	CPUUsage time.Duration
+	// MemoryUsage is the memory used by the running process and its children,
+	// in bytes.
+	MemoryUsage uint64
+}
+
+// SetUsage sets the CPU and memory usage from the cgroup metrics, the page
+// cache which can be reclaimed is not counted as used.
+func (s *Stats) SetUsage(data *v1.Metrics) {
+	if mem := data.Memory; mem != nil && mem.Usage != nil && mem.TotalInactiveFile < mem.Usage.Usage {
+		s.MemoryUsage = mem.Usage.Usage - mem.TotalInactiveFile
+	}
+
+	if cpu := data.CPU; cpu != nil && cpu.Usage != nil {
+		s.CPUUsage = time.Duration(cpu.Usage.Total)
+	}
+}
+
 // Args represents the required options for services.
 type Args struct {
 	ID          string
@@ -56,6 +93,41 @@ type Options struct {
 	GracefulShutdownTimeout time.Duration
 	// Stdin is the process standard input.
 	Stdin io.ReadSeeker
+	// Sandbox describes the restrictions applied by the process runner.
+	Sandbox Sandbox
+	// CgroupPath is the cgroup the process runner puts the process in,
+	// defaults to the cgroup named after the service.
+	CgroupPath string
+}
+
+// Sandbox describes the restrictions applied to the process launched by the process runner.
+//
+// Zero value means no restrictions.
+type Sandbox struct {
+	// DropCapabilities lists the capabilities removed from the bounding,
+	// effective, permitted and inheritable sets of the process.
+	DropCapabilities []capability.Cap
+	// SeccompDeniedSyscalls lists syscall numbers which fail with EPERM.
+	SeccompDeniedSyscalls []uintptr
+	// Namespaces is the mask of namespaces (syscall.CLONE_NEW*) to create for the process,
+	// only mount, PID and UTS namespaces are supported.
+	Namespaces uintptr
+	// ReadonlyRootfs makes the root mount read-only, implies new mount namespace.
+	ReadonlyRootfs bool
+	// NoNewPrivileges sets the no_new_privs bit for the process.
+	NoNewPrivileges bool
+	// Credential is the user and group to run the process as.
+	Credential *syscall.Credential
+}
+
+// Enabled returns true if any restriction is set.
+func (s *Sandbox) Enabled() bool {
+	return len(s.DropCapabilities) > 0 ||
+		len(s.SeccompDeniedSyscalls) > 0 ||
+		s.Namespaces != 0 ||
+		s.ReadonlyRootfs ||
+		s.NoNewPrivileges ||
+		s.Credential != nil
 }
 
 // Option is the functional option func.
@@ -135,3 +207,55 @@ func WithStdin(stdin io.ReadSeeker) Option {
 		args.Stdin = stdin
 	}
 }
+
+// WithDroppedCapabilities sets the capabilities to drop from the process.
+func WithDroppedCapabilities(caps ...capability.Cap) Option {
+	return func(args *Options) {
+		args.Sandbox.DropCapabilities = caps
+	}
+}
+
+// WithSeccompDeniedSyscalls sets the syscalls denied by the seccomp filter.
+func WithSeccompDeniedSyscalls(syscalls ...uintptr) Option {
+	return func(args *Options) {
+		args.Sandbox.SeccompDeniedSyscalls = syscalls
+	}
+}
+
+// WithNamespaces sets the namespaces to create for the process.
+func WithNamespaces(flags uintptr) Option {
+	return func(args *Options) {
+		args.Sandbox.Namespaces = flags
+	}
+}
+
+// WithReadonlyRootfs makes the root mount read-only for the process.
+func WithReadonlyRootfs() Option {
+	return func(args *Options) {
+		args.Sandbox.ReadonlyRootfs = true
+	}
+}
+
+// WithNoNewPrivileges sets the no_new_privs bit for the process.
+func WithNoNewPrivileges() Option {
+	return func(args *Options) {
+		args.Sandbox.NoNewPrivileges = true
+	}
+}
+
+// WithCgroupPath sets the cgroup the process is put in.
+func WithCgroupPath(path string) Option {
+	return func(args *Options) {
+		args.CgroupPath = path
+	}
+}
+
+// WithCredential sets the user and group to run the process as.
+func WithCredential(uid, gid uint32) Option {
+	return func(args *Options) {
+		args.Sandbox.Credential = &syscall.Credential{
+			Uid: uid,
+			Gid: gid,
+		}
+	}
+}
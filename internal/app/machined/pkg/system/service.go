@@ -6,6 +6,7 @@ package system
 
 import (
 	"context"
+	"time"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
@@ -53,3 +54,8 @@ type APIStoppableService interface {
 type APIRestartableService interface {
 	APIRestartAllowed(runtime.Runtime) bool
 }
+
+// ShutdownTimeoutService is a service which overrides default shutdown timeout.
+type ShutdownTimeoutService interface {
+	ShutdownTimeout(runtime.Runtime) time.Duration
+}
@@ -11,6 +11,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
+
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/health"
@@ -38,6 +40,11 @@ type ServiceRunner struct {
 
 	healthState health.State
 
+	runnr     runner.Runner
+	lastStats runner.Stats
+	started   time.Time
+	restarts  uint32
+
 	stateSubscribers map[StateEvent][]chan<- struct{}
 
 	ctxMu     sync.Mutex
@@ -78,6 +85,14 @@ func (svcrunner *ServiceRunner) UpdateState(newstate events.ServiceState, messag
 		Timestamp: time.Now(),
 	}
 
+	if newstate == events.StateRunning && svcrunner.state != events.StateRunning {
+		if !svcrunner.started.IsZero() {
+			svcrunner.restarts++
+		}
+
+		svcrunner.started = event.Timestamp
+	}
+
 	svcrunner.state = newstate
 	svcrunner.events.Push(event)
 
@@ -264,6 +279,9 @@ func (svcrunner *ServiceRunner) run(ctx context.Context, runnr runner.Runner) er
 	// nolint: errcheck
 	defer runnr.Close()
 
+	svcrunner.setRunner(runnr)
+	defer svcrunner.setRunner(nil)
+
 	errCh := make(chan error)
 
 	go func() {
@@ -326,6 +344,42 @@ func (svcrunner *ServiceRunner) run(ctx context.Context, runnr runner.Runner) er
 	return nil
 }
 
+func (svcrunner *ServiceRunner) setRunner(runnr runner.Runner) {
+	if runnr == nil {
+		// keep last exit status around once the runner is gone
+		stats := svcrunner.getStats()
+
+		svcrunner.mu.Lock()
+		svcrunner.lastStats = runner.Stats{
+			ExitCode:   stats.ExitCode,
+			ExitSignal: stats.ExitSignal,
+		}
+		svcrunner.mu.Unlock()
+	}
+
+	svcrunner.mu.Lock()
+	svcrunner.runnr = runnr
+	svcrunner.mu.Unlock()
+}
+
+// getStats returns runtime statistics of the service runner.
+//
+// Statistics are sampled from the runner (if it supports that), errors are ignored
+// as stats are best effort.
+func (svcrunner *ServiceRunner) getStats() runner.Stats {
+	svcrunner.mu.Lock()
+	runnr := svcrunner.runnr
+	stats := svcrunner.lastStats
+	svcrunner.mu.Unlock()
+
+	if reporter, ok := runnr.(runner.StatsReporter); ok {
+		// nolint: errcheck
+		stats, _ = reporter.Stats()
+	}
+
+	return stats
+}
+
 // Shutdown initiates shutdown of the service runner
 //
 // Shutdown completes when Start() returns.
@@ -337,14 +391,34 @@ func (svcrunner *ServiceRunner) Shutdown() {
 
 // AsProto returns protobuf struct with the state of the service runner.
 func (svcrunner *ServiceRunner) AsProto() *machineapi.ServiceInfo {
+	stats := svcrunner.getStats()
+
 	svcrunner.mu.Lock()
 	defer svcrunner.mu.Unlock()
 
+	statsProto := &machineapi.ServiceStats{
+		Restarts:     svcrunner.restarts,
+		Pid:          int32(stats.PID),
+		LastExitCode: int32(stats.ExitCode),
+		CpuUsage:     uint64(stats.CPUUsage),
+		MemoryUsage:  stats.MemoryUsage,
+	}
+
+	if stats.ExitSignal != 0 {
+		statsProto.LastExitSignal = stats.ExitSignal.String()
+	}
+
+	if !svcrunner.started.IsZero() {
+		// nolint: errcheck
+		statsProto.Started, _ = ptypes.TimestampProto(svcrunner.started)
+	}
+
 	return &machineapi.ServiceInfo{
 		Id:     svcrunner.id,
 		State:  svcrunner.state.String(),
 		Events: svcrunner.events.AsProto(events.MaxEventsToKeep),
 		Health: svcrunner.healthState.AsProto(),
+		Stats:  statsProto,
 	}
 }
 
@@ -75,6 +75,8 @@ func (suite *ServiceRunnerSuite) TestFullFlow() {
 	suite.Assert().Equal("Finished", protoService.State)
 	suite.Assert().True(protoService.Health.Unknown)
 	suite.Assert().Len(protoService.Events.Events, 5)
+	suite.Assert().NotNil(protoService.Stats.Started)
+	suite.Assert().EqualValues(0, protoService.Stats.Restarts)
 }
 
 func (suite *ServiceRunnerSuite) TestFullFlowHealthy() {
@@ -460,6 +462,8 @@ func (suite *ServiceRunnerSuite) TestFullFlowRestart() {
 		events.StateRunning,
 		events.StateFinished,
 	}, sr)
+
+	suite.Assert().EqualValues(1, sr.AsProto().Stats.Restarts)
 }
 
 func TestServiceRunnerSuite(t *testing.T) {
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"time"
 
 	"github.com/containerd/containerd"
 	"google.golang.org/grpc/health/grpc_health_v1"
@@ -74,6 +75,7 @@ func (c *Containerd) Runner(r runtime.Runtime) (runner.Runner, error) {
 		args,
 		runner.WithLoggingManager(r.Logging()),
 		runner.WithEnv(env),
+		runner.WithGracefulShutdownTimeout(30*time.Second),
 	),
 		restart.WithType(restart.Forever),
 	), nil
@@ -102,6 +104,13 @@ func (c *Containerd) HealthFunc(runtime.Runtime) health.Check {
 	}
 }
 
+// ShutdownTimeout implements the ShutdownTimeoutService interface.
+//
+// containerd is given more time to tear down the containers it still runs.
+func (c *Containerd) ShutdownTimeout(runtime.Runtime) time.Duration {
+	return time.Minute
+}
+
 // HealthSettings implements the HealthcheckedService interface.
 func (c *Containerd) HealthSettings(runtime.Runtime) *health.Settings {
 	return &health.DefaultSettings
@@ -6,6 +6,7 @@ package services_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -15,4 +16,9 @@ import (
 
 func TestSystemContainerdInterfaces(t *testing.T) {
 	assert.Implements(t, (*system.HealthcheckedService)(nil), new(services.Containerd))
+	assert.Implements(t, (*system.ShutdownTimeoutService)(nil), new(services.Containerd))
+}
+
+func TestSystemContainerdShutdownTimeout(t *testing.T) {
+	assert.Equal(t, time.Minute, new(services.Containerd).ShutdownTimeout(nil))
 }
@@ -11,7 +11,9 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log"
 	"os"
+	"path/filepath"
 	goruntime "runtime"
 	"strings"
 	"time"
@@ -24,7 +26,9 @@ import (
 	"github.com/containerd/containerd/oci"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/clientv3/snapshot"
 	"go.etcd.io/etcd/etcdserver/api/v3rpc/rpctypes"
+	"go.uber.org/zap"
 
 	"github.com/talos-systems/crypto/x509"
 	"github.com/talos-systems/go-retry/retry"
@@ -50,6 +54,10 @@ import (
 // the required methods.
 type Etcd struct {
 	Bootstrap bool
+	// RecoverFromSnapshot initializes the data directory of the bootstrapped
+	// etcd from the snapshot uploaded with the EtcdRecover API.
+	RecoverFromSnapshot  bool
+	RecoverSkipHashCheck bool
 
 	args []string
 }
@@ -126,6 +134,7 @@ func (e *Etcd) Runner(r runtime.Runtime) (runner.Runner, error) {
 			oci.WithHostNamespace(specs.NetworkNamespace),
 			oci.WithMounts(mounts),
 		),
+		runner.WithGracefulShutdownTimeout(30*time.Second),
 	),
 		restart.WithType(restart.Forever),
 	), nil
@@ -158,6 +167,13 @@ func (e *Etcd) HealthFunc(runtime.Runtime) health.Check {
 	}
 }
 
+// ShutdownTimeout implements the ShutdownTimeoutService interface.
+//
+// etcd is given more time to sync the data to disk before it's killed.
+func (e *Etcd) ShutdownTimeout(runtime.Runtime) time.Duration {
+	return time.Minute
+}
+
 // HealthSettings implements the HealthcheckedService interface.
 func (e *Etcd) HealthSettings(runtime.Runtime) *health.Settings {
 	return &health.Settings{
@@ -481,6 +497,12 @@ func (e *Etcd) setup(ctx context.Context, r runtime.Runtime, errCh chan error) {
 			return fmt.Errorf("failed to pull image %q: %w", r.Config().Cluster().Etcd().Image(), err)
 		}
 
+		if e.RecoverFromSnapshot {
+			if err = e.recoverFromSnapshot(); err != nil {
+				return err
+			}
+		}
+
 		switch r.Config().Machine().Type() { //nolint: exhaustive
 		case machine.TypeInit:
 			err = e.argsForInit(ctx, r)
@@ -584,6 +606,65 @@ func (e *Etcd) argsForControlPlane(ctx context.Context, r runtime.Runtime) error
 	return nil
 }
 
+// recoverFromSnapshot restores the etcd data directory from the snapshot as a
+// single member cluster, the data directory should be empty.
+func (e *Etcd) recoverFromSnapshot() error {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+
+	primaryAddr, _, err := primaryAndListenAddresses()
+	if err != nil {
+		return fmt.Errorf("failed to calculate etcd addresses: %w", err)
+	}
+
+	manager := snapshot.NewV3(zap.NewNop())
+
+	status, err := manager.Status(constants.EtcdRecoverySnapshotPath)
+	if err != nil {
+		return fmt.Errorf("error verifying snapshot: %w", err)
+	}
+
+	log.Printf("recovering etcd from snapshot: hash %08x, revision %d, total keys %d, total size %d\n",
+		status.Hash, status.Revision, status.TotalKey, status.TotalSize)
+
+	// the snapshot is restored next to the data directory, as etcd refuses to
+	// restore into the existing directory, while the data directory is
+	// bind mounted into the etcd container
+	restoreDir := constants.EtcdDataPath + ".restore"
+
+	if err = os.RemoveAll(restoreDir); err != nil {
+		return err
+	}
+
+	// nolint: errcheck
+	defer os.RemoveAll(restoreDir)
+
+	peerURL := "https://" + net.FormatAddress(primaryAddr) + ":2380"
+
+	if err = manager.Restore(snapshot.RestoreConfig{
+		SnapshotPath:   constants.EtcdRecoverySnapshotPath,
+		Name:           hostname,
+		OutputDataDir:  restoreDir,
+		PeerURLs:       []string{peerURL},
+		InitialCluster: fmt.Sprintf("%s=%s", hostname, peerURL),
+		SkipHashCheck:  e.RecoverSkipHashCheck,
+	}); err != nil {
+		return fmt.Errorf("error recovering etcd from snapshot: %w", err)
+	}
+
+	if err = os.Rename(filepath.Join(restoreDir, "member"), filepath.Join(constants.EtcdDataPath, "member")); err != nil {
+		return err
+	}
+
+	if err = os.Remove(constants.EtcdRecoverySnapshotPath); err != nil {
+		return fmt.Errorf("error deleting snapshot: %w", err)
+	}
+
+	return nil
+}
+
 // IsDirEmpty checks if a directory is empty or not.
 func IsDirEmpty(name string) (bool, error) {
 	f, err := os.Open(name)
@@ -0,0 +1,214 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/health"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/goroutine"
+	"github.com/talos-systems/talos/internal/pkg/etcd"
+	"github.com/talos-systems/talos/internal/pkg/etcd/backup"
+	"github.com/talos-systems/talos/internal/pkg/s3"
+	"github.com/talos-systems/talos/pkg/conditions"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// EtcdBackup implements the Service interface. It takes the scheduled etcd
+// backups.
+type EtcdBackup struct {
+	mu          sync.Mutex
+	lastSuccess time.Time
+	lastErr     error
+	eventSink   events.Recorder
+}
+
+// ID implements the Service interface.
+func (e *EtcdBackup) ID(r runtime.Runtime) string {
+	return "etcd-backup"
+}
+
+// PreFunc implements the Service interface.
+func (e *EtcdBackup) PreFunc(ctx context.Context, r runtime.Runtime) error {
+	return nil
+}
+
+// PostFunc implements the Service interface.
+func (e *EtcdBackup) PostFunc(r runtime.Runtime, state events.ServiceState) (err error) {
+	return nil
+}
+
+// Condition implements the Service interface.
+func (e *EtcdBackup) Condition(r runtime.Runtime) conditions.Condition {
+	return nil
+}
+
+// DependsOn implements the Service interface.
+func (e *EtcdBackup) DependsOn(r runtime.Runtime) []string {
+	return []string{"etcd"}
+}
+
+// Runner implements the Service interface.
+func (e *EtcdBackup) Runner(r runtime.Runtime) (runner.Runner, error) {
+	return &etcdBackupRunner{
+		Runner: goroutine.NewRunner(r, e.ID(r), e.main, runner.WithLoggingManager(r.Logging())),
+		backup: e,
+	}, nil
+}
+
+// etcdBackupRunner keeps the service event sink, so that the successful
+// backups are recorded as the service events.
+type etcdBackupRunner struct {
+	runner.Runner
+
+	backup *EtcdBackup
+}
+
+// Run implements the Runner interface.
+func (r *etcdBackupRunner) Run(eventSink events.Recorder) error {
+	r.backup.mu.Lock()
+	r.backup.eventSink = eventSink
+	r.backup.mu.Unlock()
+
+	return r.Runner.Run(eventSink)
+}
+
+// HealthFunc implements the HealthcheckedService interface.
+//
+// The service is healthy unless the last backup failed.
+func (e *EtcdBackup) HealthFunc(runtime.Runtime) health.Check {
+	return func(ctx context.Context) error {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+
+		if e.lastErr == nil {
+			return nil
+		}
+
+		return fmt.Errorf("backup failed: %s, %s", e.lastErr, e.lastSuccessMessage())
+	}
+}
+
+// HealthSettings implements the HealthcheckedService interface.
+//
+// The time of the last successful backup is reported in the health message.
+func (e *EtcdBackup) HealthSettings(runtime.Runtime) *health.Settings {
+	settings := health.DefaultSettings
+
+	settings.HealthyMessage = func() string {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+
+		return e.lastSuccessMessage()
+	}
+
+	return &settings
+}
+
+func (e *EtcdBackup) lastSuccessMessage() string {
+	if e.lastSuccess.IsZero() {
+		return "no backups taken yet"
+	}
+
+	return "last successful backup at " + e.lastSuccess.Format(time.RFC3339)
+}
+
+func (e *EtcdBackup) main(ctx context.Context, r runtime.Runtime, logWriter io.Writer) error {
+	logger := log.New(logWriter, "", log.LstdFlags)
+
+	cfg := r.Config().Cluster().Etcd().Backup()
+
+	ticker := time.NewTicker(cfg.Interval())
+	defer ticker.Stop()
+
+	for {
+		path, notLeader, err := e.run(ctx, cfg)
+
+		switch {
+		case err != nil:
+			logger.Printf("etcd backup failed: %s", err)
+		case notLeader:
+			logger.Printf("etcd backup skipped, the node is not the etcd leader")
+		default:
+			logger.Printf("etcd backup saved to %s", path)
+		}
+
+		e.mu.Lock()
+		e.lastErr = err
+
+		if err == nil && !notLeader {
+			e.lastSuccess = time.Now()
+
+			if e.eventSink != nil {
+				e.eventSink(events.StateRunning, "Backup %s taken", filepath.Base(path))
+			}
+		}
+		e.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *EtcdBackup) run(ctx context.Context, cfg config.EtcdBackup) (path string, notLeader bool, err error) {
+	ctx, cancel := context.WithTimeout(ctx, cfg.Interval())
+	defer cancel()
+
+	client, err := etcd.NewClient([]string{"127.0.0.1:2379"})
+	if err != nil {
+		return "", false, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	// nolint: errcheck
+	defer client.Close()
+
+	if cfg.LeaderOnly() {
+		leader, err := etcd.IsLeader(ctx, client)
+		if err != nil {
+			return "", false, err
+		}
+
+		if !leader {
+			return "", true, nil
+		}
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", false, err
+	}
+
+	opts := backup.Options{
+		Dir:       constants.EtcdBackupPath,
+		Node:      hostname,
+		Retention: cfg.Retention(),
+	}
+
+	if s3cfg := cfg.S3(); s3cfg != nil {
+		if opts.Store, err = s3.NewClient(s3cfg.Endpoint(), s3cfg.Region(), s3cfg.Bucket(), s3cfg.AccessKeyID(), s3cfg.SecretAccessKey()); err != nil {
+			return "", false, err
+		}
+
+		opts.StorePrefix = s3cfg.Prefix()
+	}
+
+	path, err = backup.Run(ctx, client, opts, time.Now())
+
+	return path, false, err
+}
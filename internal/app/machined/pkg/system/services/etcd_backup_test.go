@@ -0,0 +1,33 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/health"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/services"
+)
+
+func TestEtcdBackupInterfaces(t *testing.T) {
+	assert.Implements(t, (*system.HealthcheckedService)(nil), new(services.EtcdBackup))
+}
+
+func TestEtcdBackupHealth(t *testing.T) {
+	assert.NoError(t, new(services.EtcdBackup).HealthFunc(nil)(context.Background()))
+}
+
+func TestEtcdBackupHealthyMessage(t *testing.T) {
+	settings := new(services.EtcdBackup).HealthSettings(nil)
+
+	assert.Equal(t, "no backups taken yet", settings.HealthyMessage())
+
+	// the default settings are not modified
+	assert.Nil(t, health.DefaultSettings.HealthyMessage)
+}
@@ -6,6 +6,7 @@ package services_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -15,4 +16,9 @@ import (
 
 func TestEtcdInterfaces(t *testing.T) {
 	assert.Implements(t, (*system.HealthcheckedService)(nil), new(services.Etcd))
+	assert.Implements(t, (*system.ShutdownTimeoutService)(nil), new(services.Etcd))
+}
+
+func TestEtcdShutdownTimeout(t *testing.T) {
+	assert.Equal(t, time.Minute, new(services.Etcd).ShutdownTimeout(nil))
 }
@@ -228,6 +228,14 @@ func (k *Kubelet) HealthFunc(runtime.Runtime) health.Check {
 	}
 }
 
+// ShutdownTimeout implements the ShutdownTimeoutService interface.
+//
+// kubelet has no state to persist, so the services it depends on are not held
+// back by the kubelet being slow to stop.
+func (k *Kubelet) ShutdownTimeout(runtime.Runtime) time.Duration {
+	return 15 * time.Second
+}
+
 // HealthSettings implements the HealthcheckedService interface.
 func (k *Kubelet) HealthSettings(runtime.Runtime) *health.Settings {
 	settings := health.DefaultSettings
@@ -6,6 +6,7 @@ package services_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -15,4 +16,9 @@ import (
 
 func TestKubeletInterfaces(t *testing.T) {
 	assert.Implements(t, (*system.HealthcheckedService)(nil), new(services.Kubelet))
+	assert.Implements(t, (*system.ShutdownTimeoutService)(nil), new(services.Kubelet))
+}
+
+func TestKubeletShutdownTimeout(t *testing.T) {
+	assert.Equal(t, 15*time.Second, new(services.Kubelet).ShutdownTimeout(nil))
 }
@@ -0,0 +1,150 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/goroutine"
+	"github.com/talos-systems/talos/internal/pkg/etcd"
+	"github.com/talos-systems/talos/internal/pkg/manifests"
+	"github.com/talos-systems/talos/pkg/conditions"
+	"github.com/talos-systems/talos/pkg/kubernetes"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+// ManifestSync implements the Service interface. It keeps the manifests from
+// the machine config applied to the cluster.
+//
+// The service isn't health checked, as the first syncs on the init node fail
+// until the cluster is bootstrapped, the results of the syncs are published as
+// the events.
+type ManifestSync struct{}
+
+// ID implements the Service interface.
+func (m *ManifestSync) ID(r runtime.Runtime) string {
+	return "manifest-sync"
+}
+
+// PreFunc implements the Service interface.
+func (m *ManifestSync) PreFunc(ctx context.Context, r runtime.Runtime) error {
+	return nil
+}
+
+// PostFunc implements the Service interface.
+func (m *ManifestSync) PostFunc(r runtime.Runtime, state events.ServiceState) (err error) {
+	return nil
+}
+
+// Condition implements the Service interface.
+func (m *ManifestSync) Condition(r runtime.Runtime) conditions.Condition {
+	return nil
+}
+
+// DependsOn implements the Service interface.
+func (m *ManifestSync) DependsOn(r runtime.Runtime) []string {
+	return []string{"etcd"}
+}
+
+// Runner implements the Service interface.
+func (m *ManifestSync) Runner(r runtime.Runtime) (runner.Runner, error) {
+	return goroutine.NewRunner(r, m.ID(r), m.main, runner.WithLoggingManager(r.Logging())), nil
+}
+
+func (m *ManifestSync) main(ctx context.Context, r runtime.Runtime, logWriter io.Writer) error {
+	logger := log.New(logWriter, "", log.LstdFlags)
+
+	ticker := time.NewTicker(r.Config().Cluster().ManifestSync().Interval())
+	defer ticker.Stop()
+
+	for {
+		if err := m.run(ctx, r, logger); err != nil {
+			logger.Printf("manifest sync failed: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// run syncs the manifests on the etcd leader only, so that the control plane
+// nodes don't race with each other applying and pruning the objects.
+func (m *ManifestSync) run(ctx context.Context, r runtime.Runtime, logger *log.Logger) error {
+	cfg := r.Config().Cluster()
+
+	ctx, cancel := context.WithTimeout(ctx, cfg.ManifestSync().Interval())
+	defer cancel()
+
+	client, err := etcd.NewClient([]string{"127.0.0.1:2379"})
+	if err != nil {
+		return fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	// nolint: errcheck
+	defer client.Close()
+
+	leader, err := etcd.IsLeader(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if !leader {
+		return nil
+	}
+
+	restConfig, err := kubernetes.NewTemporaryClientConfigFromPKI(cfg.CA(), cfg.Endpoint())
+	if err != nil {
+		return err
+	}
+
+	syncer, err := manifests.NewSyncer(restConfig)
+	if err != nil {
+		return err
+	}
+
+	changes, err := syncer.Sync(ctx, cfg.ManifestSync().Manifests(), cfg.ManifestSync().Prune())
+
+	for _, change := range changes {
+		event := &machine.ManifestSyncEvent{
+			Manifest: change.Object.Manifest,
+		}
+
+		if change.Object.Name != "" {
+			event.Object = change.Object.String()
+		}
+
+		switch change.Action {
+		case manifests.Created:
+			event.Action = machine.ManifestSyncEvent_CREATED
+		case manifests.Updated:
+			event.Action = machine.ManifestSyncEvent_UPDATED
+		case manifests.Pruned:
+			event.Action = machine.ManifestSyncEvent_PRUNED
+		case manifests.Failed:
+			event.Action = machine.ManifestSyncEvent_FAILED
+			event.Error = change.Err.Error()
+		}
+
+		logger.Printf("manifest %q: %s %s %s", event.Manifest, event.Action, event.Object, event.Error)
+
+		r.Events().Publish(event)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return manifests.Error(changes)
+}
@@ -0,0 +1,22 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/services"
+)
+
+func TestManifestSyncInterfaces(t *testing.T) {
+	assert.Implements(t, (*system.Service)(nil), new(services.ManifestSync))
+
+	// the sync failures don't block the boot
+	_, ok := interface{}(new(services.ManifestSync)).(system.HealthcheckedService)
+	assert.False(t, ok)
+}
@@ -7,8 +7,12 @@ package services
 import (
 	"context"
 	"fmt"
+	"syscall"
 	"time"
 
+	"github.com/syndtr/gocapability/capability"
+	"golang.org/x/sys/unix"
+
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/health"
@@ -77,6 +81,13 @@ func (c *Udevd) Runner(r runtime.Runtime) (runner.Runner, error) {
 		args,
 		runner.WithLoggingManager(r.Logging()),
 		runner.WithEnv(env),
+		// udevd needs to load modules and manage device nodes, but it has no business
+		// rebooting the machine or setting the clock, and the UTS namespace keeps
+		// it from changing the hostname of the node
+		runner.WithDroppedCapabilities(capability.CAP_SYS_BOOT, capability.CAP_SYS_TIME),
+		runner.WithSeccompDeniedSyscalls(unix.SYS_REBOOT, unix.SYS_KEXEC_LOAD, unix.SYS_KEXEC_FILE_LOAD),
+		runner.WithNamespaces(syscall.CLONE_NEWUTS),
+		runner.WithNoNewPrivileges(),
 	),
 		restart.WithType(restart.Forever),
 	), nil
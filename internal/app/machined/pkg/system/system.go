@@ -7,6 +7,7 @@ package system
 import (
 	"context"
 	"fmt"
+	"log"
 	"sort"
 	"sync"
 	"time"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/pkg/conditions"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
 )
 
 type singleton struct {
@@ -54,18 +56,25 @@ func Services(runtime runtime.Runtime) *singleton {
 	return instance
 }
 
+// ShutdownTimeout is the default time to wait for a single service to shut down.
+//
+// Services might override it by implementing ShutdownTimeoutService.
+var ShutdownTimeout = 30 * time.Second
+
 // Load adds service to the list of services managed by the runner.
 //
-// Load returns service IDs for each of the services.
-func (s *singleton) Load(services ...Service) []string {
+// Load returns service IDs for each of the services. If loading the services
+// would introduce a dependency cycle, none of the services are loaded.
+func (s *singleton) Load(services ...Service) ([]string, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	if s.terminating {
-		return nil
+		return nil, nil
 	}
 
 	ids := make([]string, 0, len(services))
+	newRunners := make(map[string]*ServiceRunner, len(services))
 
 	for _, service := range services {
 		id := service.ID(s.runtime)
@@ -76,11 +85,28 @@ func (s *singleton) Load(services ...Service) []string {
 			continue
 		}
 
-		svcrunner := NewServiceRunner(service, s.runtime)
+		if _, exists := newRunners[id]; exists {
+			continue
+		}
+
+		newRunners[id] = NewServiceRunner(service, s.runtime)
+	}
+
+	graph := s.dependencyGraph(s.state)
+
+	for id, svcrunner := range newRunners {
+		graph[id] = svcrunner.service.DependsOn(s.runtime)
+	}
+
+	if err := graph.checkCycles(); err != nil {
+		return nil, err
+	}
+
+	for id, svcrunner := range newRunners {
 		s.state[id] = svcrunner
 	}
 
-	return ids
+	return ids, nil
 }
 
 // Unload stops the service and removes it from the list of running services.
@@ -187,7 +213,13 @@ func (s *singleton) StartAll() {
 
 // LoadAndStart combines Load and Start into single call.
 func (s *singleton) LoadAndStart(services ...Service) {
-	err := s.Start(s.Load(services...)...)
+	ids, err := s.Load(services...)
+	if err != nil {
+		// should never happen, as dependencies are static
+		panic(err)
+	}
+
+	err = s.Start(ids...)
 	if err != nil {
 		// should never happen
 		panic(err)
@@ -210,39 +242,31 @@ func (s *singleton) Shutdown() {
 	}
 	s.mu.Unlock()
 
-	// build reverse dependencies
-	reverseDependencies := make(map[string][]string)
+	// services are shut down in reverse topological order: each service is stopped
+	// only once all the services which depend on it are stopped
+	dependents := s.dependencyGraph(stateCopy).reverse()
 
-	for name, svcrunner := range stateCopy {
-		for _, dependency := range svcrunner.service.DependsOn(s.runtime) {
-			reverseDependencies[dependency] = append(reverseDependencies[dependency], name)
-		}
+	stopped := make(map[string]chan struct{}, len(stateCopy))
+
+	for id := range stateCopy {
+		stopped[id] = make(chan struct{})
 	}
 
-	// shutdown all the services waiting for rev deps
 	var shutdownWg sync.WaitGroup
 
-	// wait max 30 seconds for reverse deps to shut down
-	shutdownCtx, shutdownCtxCancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer shutdownCtxCancel()
-
-	for name, svcrunner := range stateCopy {
+	for id, svcrunner := range stateCopy {
 		shutdownWg.Add(1)
 
-		go func(svcrunner *ServiceRunner, reverseDeps []string) {
+		go func(id string, svcrunner *ServiceRunner) {
 			defer shutdownWg.Done()
+			defer close(stopped[id])
 
-			conds := make([]conditions.Condition, len(reverseDeps))
-
-			for i := range reverseDeps {
-				conds[i] = WaitForService(StateEventDown, reverseDeps[i])
+			for _, dependent := range dependents[id] {
+				<-stopped[dependent]
 			}
 
-			// nolint: errcheck
-			_ = conditions.WaitForAll(conds...).Wait(shutdownCtx)
-
-			svcrunner.Shutdown()
-		}(svcrunner, reverseDependencies[name])
+			s.shutdownService(id, svcrunner)
+		}(id, svcrunner)
 	}
 
 	shutdownWg.Wait()
@@ -250,6 +274,83 @@ func (s *singleton) Shutdown() {
 	s.wg.Wait()
 }
 
+// shutdownService stops a single service waiting for it to go down.
+//
+// If the service doesn't go down within its shutdown timeout, shutdown proceeds
+// with the next services.
+func (s *singleton) shutdownService(id string, svcrunner *ServiceRunner) {
+	s.runningMu.Lock()
+	_, running := s.running[id]
+	s.runningMu.Unlock()
+
+	if !running {
+		return
+	}
+
+	timeout := ShutdownTimeout
+
+	if svc, ok := svcrunner.service.(ShutdownTimeoutService); ok {
+		timeout = svc.ShutdownTimeout(s.runtime)
+	}
+
+	ctx, ctxCancel := context.WithTimeout(context.Background(), timeout)
+	defer ctxCancel()
+
+	notifyCh := make(chan struct{}, 1)
+
+	svcrunner.Subscribe(StateEventDown, notifyCh)
+	defer svcrunner.Unsubscribe(StateEventDown, notifyCh)
+
+	svcrunner.Shutdown()
+
+	select {
+	case <-notifyCh:
+	case <-ctx.Done():
+		log.Printf("service[%s]: timed out after %s waiting for service to shut down", id, timeout)
+	}
+}
+
+// Graph returns dependency graph of the services.
+//
+// Services are returned in topological order: each service comes after
+// all the services it depends on.
+func (s *singleton) Graph() []*machineapi.ServiceGraphNode {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	graph := s.dependencyGraph(s.state)
+
+	result := make([]*machineapi.ServiceGraphNode, 0, len(graph))
+
+	for _, id := range graph.topologicalSort() {
+		svcrunner := s.state[id]
+
+		node := &machineapi.ServiceGraphNode{
+			Id:        id,
+			DependsOn: graph[id],
+			State:     svcrunner.GetState().String(),
+		}
+
+		if condition := svcrunner.service.Condition(s.runtime); condition != nil {
+			node.Condition = condition.String()
+		}
+
+		result = append(result, node)
+	}
+
+	return result
+}
+
+func (s *singleton) dependencyGraph(runners map[string]*ServiceRunner) dependencyGraph {
+	graph := make(dependencyGraph, len(runners))
+
+	for id, svcrunner := range runners {
+		graph[id] = svcrunner.service.DependsOn(s.runtime)
+	}
+
+	return graph
+}
+
 // List returns snapshot of ServiceRunner instances.
 func (s *singleton) List() (result []*ServiceRunner) {
 	s.mu.Lock()
@@ -6,29 +6,114 @@ package system_test
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
 )
 
 type SystemServicesSuite struct {
 	suite.Suite
 }
 
+func (suite *SystemServicesSuite) TestLoadCycle() {
+	_, err := system.Services(nil).Load(
+		&MockService{name: "cycle-a", dependencies: []string{"cycle-c"}},
+		&MockService{name: "cycle-b", dependencies: []string{"cycle-a"}},
+		&MockService{name: "cycle-c", dependencies: []string{"cycle-b"}},
+	)
+	suite.Require().EqualError(err, "service dependency cycle detected: cycle-a -> cycle-c -> cycle-b -> cycle-a")
+
+	for _, node := range system.Services(nil).Graph() {
+		suite.Assert().NotContains([]string{"cycle-a", "cycle-b", "cycle-c"}, node.Id)
+	}
+}
+
 func (suite *SystemServicesSuite) TestStartShutdown() {
+	var (
+		stopOrderMu sync.Mutex
+		stopOrder   []string
+	)
+
+	recordingService := func(name string, dependencies ...string) *MockService {
+		return &MockService{
+			name:         name,
+			dependencies: dependencies,
+			runner: &MockRunner{
+				exitCh: make(chan error),
+				onStop: func() {
+					stopOrderMu.Lock()
+					defer stopOrderMu.Unlock()
+
+					stopOrder = append(stopOrder, name)
+				},
+			},
+		}
+	}
+
+	// kubelet is slow to stop, but its shutdown timeout is short, so cri is
+	// stopped before kubelet is down
+	kubelet := &MockShutdownTimeoutService{
+		MockService: MockService{
+			name:         "kubelet",
+			dependencies: []string{"cri", "networkd"},
+			runner: &MockRunner{
+				exitCh: make(chan error),
+				onStop: func() {
+					stopOrderMu.Lock()
+					stopOrder = append(stopOrder, "kubelet")
+					stopOrderMu.Unlock()
+
+					time.Sleep(time.Second)
+
+					stopOrderMu.Lock()
+					stopOrder = append(stopOrder, "kubelet down")
+					stopOrderMu.Unlock()
+				},
+			},
+		},
+		shutdownTimeout: 100 * time.Millisecond,
+	}
+
 	system.Services(nil).LoadAndStart(
 		&MockService{name: "containerd"},
 		&MockService{name: "trustd", dependencies: []string{"containerd"}},
 		&MockService{name: "machined", dependencies: []string{"containerd", "trustd"}},
+		kubelet,
+		recordingService("cri", "networkd"),
+		recordingService("networkd"),
 	)
-	time.Sleep(10 * time.Millisecond)
+
+	suite.Require().NoError(retry.Constant(time.Minute, retry.WithUnits(10*time.Millisecond)).Retry(func() error {
+		for _, node := range system.Services(nil).Graph() {
+			if node.Id == "kubelet" && node.State == events.StateRunning.String() {
+				return nil
+			}
+		}
+
+		return retry.ExpectedError(errors.New("kubelet should be running"))
+	}))
+
+	graph := system.Services(nil).Graph()
+	ids := make([]string, len(graph))
+
+	for i := range graph {
+		ids[i] = graph[i].Id
+	}
+
+	suite.Assert().Equal([]string{"containerd", "networkd", "cri", "kubelet", "trustd", "machined"}, ids)
 
 	suite.Require().NoError(system.Services(nil).Unload(context.Background(), "trustd", "notrunning"))
 
 	system.Services(nil).Shutdown()
+
+	suite.Assert().Equal([]string{"kubelet", "cri", "networkd", "kubelet down"}, stopOrder)
 }
 
 func TestSystemServicesSuite(t *testing.T) {
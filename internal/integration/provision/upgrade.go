@@ -470,7 +470,13 @@ func (suite *UpgradeSuite) upgradeKubernetes(fromVersion, toVersion string) {
 
 	suite.T().Logf("upgrading Kubernetes: %q -> %q", fromVersion, toVersion)
 
-	suite.Require().NoError(kubernetes.Upgrade(suite.ctx, suite.clusterAccess, runtime.GOARCH, fromVersion, toVersion))
+	options := kubernetes.DefaultUpgradeOptions()
+
+	options.FromVersion = fromVersion
+	options.ToVersion = toVersion
+	options.Architecture = runtime.GOARCH
+
+	suite.Require().NoError(kubernetes.Upgrade(suite.ctx, suite.clusterAccess, options))
 }
 
 // TestRolling performs rolling upgrade starting with master nodes.
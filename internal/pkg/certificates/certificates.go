@@ -0,0 +1,199 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package certificates lists the certificates Talos manages on the node.
+package certificates
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// dialTimeout is the timeout of the TLS handshake with the local services.
+const dialTimeout = 5 * time.Second
+
+// Certificate is the certificate managed by Talos.
+type Certificate struct {
+	*x509.Certificate
+
+	Name string
+	// Path is the file the certificate is stored in, it's empty for the
+	// certificates kept in the machine config or in memory.
+	Path string
+	// AutoRenewed means that the certificate is renewed before it expires
+	// without any action.
+	AutoRenewed bool
+}
+
+// Parse the first certificate of the PEM data.
+func Parse(name, path string, data []byte) (Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return Certificate{}, fmt.Errorf("%s: failed to decode PEM", name)
+	}
+
+	crt, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return Certificate{}, fmt.Errorf("%s: %w", name, err)
+	}
+
+	return Certificate{
+		Certificate: crt,
+		Name:        name,
+		Path:        path,
+	}, nil
+}
+
+// ParseKubeconfig parses the client certificates of the kubeconfig users.
+func ParseKubeconfig(path string, data []byte) ([]Certificate, error) {
+	config, err := clientcmd.Load(data)
+	if err != nil {
+		return nil, fmt.Errorf("error loading kubeconfig: %w", err)
+	}
+
+	names := make([]string, 0, len(config.AuthInfos))
+
+	for name := range config.AuthInfos {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var certs []Certificate
+
+	for _, name := range names {
+		data := config.AuthInfos[name].ClientCertificateData
+		if len(data) == 0 {
+			continue
+		}
+
+		crt, err := Parse("kubeconfig "+name, path, data)
+		if err != nil {
+			return nil, err
+		}
+
+		certs = append(certs, crt)
+	}
+
+	return certs, nil
+}
+
+// ReadFile parses the certificate stored in the file.
+func ReadFile(name, path string) (Certificate, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Certificate{}, err
+	}
+
+	return Parse(name, path, data)
+}
+
+// FromTLS returns the certificate the endpoint serves.
+func FromTLS(ctx context.Context, name, endpoint string) (Certificate, error) {
+	dialer := &net.Dialer{Timeout: dialTimeout}
+
+	conn, err := dialer.DialContext(ctx, "tcp", endpoint)
+	if err != nil {
+		return Certificate{}, err
+	}
+
+	// nolint: errcheck
+	defer conn.Close()
+
+	// the certificate is only inspected, so it isn't verified
+	tlsConn := tls.Client(conn, &tls.Config{
+		InsecureSkipVerify: true, //nolint: gosec
+	})
+
+	if err = tlsConn.SetDeadline(time.Now().Add(dialTimeout)); err != nil {
+		return Certificate{}, err
+	}
+
+	// the handshake fails on the mutual TLS endpoints once the server
+	// requests the client certificate, but the server certificate is already
+	// received by then
+	_ = tlsConn.Handshake() //nolint: errcheck
+
+	peerCertificates := tlsConn.ConnectionState().PeerCertificates
+	if len(peerCertificates) == 0 {
+		return Certificate{}, fmt.Errorf("%s: no certificate served at %s", name, endpoint)
+	}
+
+	return Certificate{
+		Certificate: peerCertificates[0],
+		Name:        name,
+	}, nil
+}
+
+// List the certificates managed by Talos on the node.
+//
+// The CA certificates are read from the machine config, the leaf certificates
+// are read from the files they are stored in or fetched from the services
+// serving them.
+func List(ctx context.Context, cfg config.Provider) ([]Certificate, error) {
+	var certs []Certificate
+
+	add := func(crt Certificate, err error) error {
+		if err != nil {
+			return err
+		}
+
+		certs = append(certs, crt)
+
+		return nil
+	}
+
+	if ca := cfg.Machine().Security().CA(); ca != nil && len(ca.Crt) > 0 {
+		if err := add(Parse("os ca", "", ca.Crt)); err != nil {
+			return nil, err
+		}
+	}
+
+	if ca := cfg.Cluster().CA(); ca != nil && len(ca.Crt) > 0 {
+		if err := add(Parse("kubernetes ca", "", ca.Crt)); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.Machine().Type() != machine.TypeJoin {
+		if ca := cfg.Cluster().Etcd().CA(); ca != nil && len(ca.Crt) > 0 {
+			if err := add(Parse("etcd ca", "", ca.Crt)); err != nil {
+				return nil, err
+			}
+		}
+
+		// the peer certificate is issued once etcd starts
+		if err := add(ReadFile("etcd peer", constants.KubernetesEtcdPeerCert)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+	}
+
+	apid, err := FromTLS(ctx, "apid", net.JoinHostPort("127.0.0.1", strconv.Itoa(constants.ApidPort)))
+	if err != nil {
+		return nil, err
+	}
+
+	// apid renews the certificate issued by trustd at the half of its lifetime
+	apid.AutoRenewed = true
+
+	certs = append(certs, apid)
+
+	return certs, nil
+}
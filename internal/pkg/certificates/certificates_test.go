@@ -0,0 +1,79 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package certificates_test
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talos-systems/crypto/x509"
+
+	"github.com/talos-systems/talos/internal/pkg/certificates"
+)
+
+func TestParse(t *testing.T) {
+	notAfter := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("talos"), x509.NotAfter(notAfter))
+	require.NoError(t, err)
+
+	crt, err := certificates.Parse("os ca", "", ca.CrtPEM)
+	require.NoError(t, err)
+
+	assert.Equal(t, "os ca", crt.Name)
+	assert.Equal(t, []string{"talos"}, crt.Subject.Organization)
+	assert.True(t, crt.IsCA)
+	assert.True(t, notAfter.Equal(crt.NotAfter))
+
+	_, err = certificates.Parse("os ca", "", []byte("garbage"))
+	assert.EqualError(t, err, "os ca: failed to decode PEM")
+}
+
+func TestParseKubeconfig(t *testing.T) {
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("talos"))
+	require.NoError(t, err)
+
+	data := []byte(fmt.Sprintf(`apiVersion: v1
+kind: Config
+users:
+- name: admin@talos
+  user:
+    client-certificate-data: %s
+- name: token
+  user:
+    token: token
+`, base64.StdEncoding.EncodeToString(ca.CrtPEM)))
+
+	certs, err := certificates.ParseKubeconfig("kubeconfig", data)
+	require.NoError(t, err)
+
+	require.Len(t, certs, 1)
+	assert.Equal(t, "kubeconfig admin@talos", certs[0].Name)
+	assert.Equal(t, "kubeconfig", certs[0].Path)
+}
+
+func TestFromTLS(t *testing.T) {
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	srv.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+	}
+
+	srv.StartTLS()
+	defer srv.Close()
+
+	crt, err := certificates.FromTLS(context.Background(), "apid", srv.Listener.Addr().String())
+	require.NoError(t, err)
+
+	assert.Equal(t, "apid", crt.Name)
+	assert.Equal(t, srv.Certificate().SerialNumber, crt.SerialNumber)
+}
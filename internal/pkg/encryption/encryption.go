@@ -0,0 +1,195 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package encryption provides the LUKS2 encryption of the system partitions.
+package encryption
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/makefs"
+)
+
+// Handler unlocks an encrypted partition, the partition is formatted on the
+// first use.
+type Handler struct {
+	partition string
+	label     string
+	cipher    string
+	keySize   uint
+	keys      []KeyHandler
+
+	nodeSecret NodeSecretFunc
+}
+
+// NewHandler creates a handler for the partition. If the config is nil, the
+// keys are discovered from the tokens stored in the LUKS2 header. The node
+// secret is required for the nodeID keys.
+func NewHandler(partition, label string, cfg config.Encryption, nodeSecret NodeSecretFunc) (*Handler, error) {
+	h := &Handler{
+		partition:  partition,
+		label:      label,
+		nodeSecret: nodeSecret,
+	}
+
+	if cfg == nil {
+		return h, nil
+	}
+
+	if cfg.Provider() != "luks2" {
+		return nil, fmt.Errorf("unsupported encryption provider %q", cfg.Provider())
+	}
+
+	h.cipher = cfg.Cipher()
+	h.keySize = cfg.KeySize()
+
+	for _, key := range cfg.Keys() {
+		k, err := NewKeyHandler(key, nodeSecret)
+		if err != nil {
+			return nil, err
+		}
+
+		h.keys = append(h.keys, k)
+	}
+
+	return h, nil
+}
+
+// Open unlocks the partition and returns the path of the unlocked device.
+func (h *Handler) Open(ctx context.Context) (string, error) {
+	path := MappedPath(h.label)
+
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+
+	encrypted, err := IsLUKS(h.partition)
+	if err != nil {
+		return "", err
+	}
+
+	if !encrypted {
+		if err = h.format(ctx); err != nil {
+			return "", err
+		}
+
+		return path, nil
+	}
+
+	keys := h.keys
+
+	if len(keys) == 0 {
+		if keys, err = h.tokenKeys(ctx); err != nil {
+			return "", err
+		}
+	}
+
+	var result *multierror.Error
+
+	for _, k := range keys {
+		var key []byte
+
+		if key, err = k.GetKey(ctx, h.label); err != nil {
+			result = multierror.Append(result, fmt.Errorf("slot %d: %w", k.Slot(), err))
+
+			continue
+		}
+
+		if err = luksOpen(ctx, h.partition, h.label, key); err != nil {
+			result = multierror.Append(result, fmt.Errorf("slot %d: %w", k.Slot(), err))
+
+			continue
+		}
+
+		return path, nil
+	}
+
+	if result == nil {
+		return "", fmt.Errorf("no keys available to unlock %s partition", h.label)
+	}
+
+	return "", fmt.Errorf("failed to unlock %s partition: %w", h.label, result)
+}
+
+// Close locks the partition.
+func (h *Handler) Close(ctx context.Context) error {
+	if _, err := os.Stat(MappedPath(h.label)); os.IsNotExist(err) {
+		return nil
+	}
+
+	return luksClose(ctx, h.label)
+}
+
+func (h *Handler) tokenKeys(ctx context.Context) ([]KeyHandler, error) {
+	tokens, err := luksTokens(ctx, h.partition)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]KeyHandler, 0, len(tokens))
+
+	for _, t := range tokens {
+		k, err := keyHandlerFromToken(t, h.nodeSecret)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, k)
+	}
+
+	return keys, nil
+}
+
+// format initializes the LUKS2 header with all the configured keys, unlocks
+// the partition and creates the filesystem on it.
+func (h *Handler) format(ctx context.Context) error {
+	if len(h.keys) == 0 {
+		return fmt.Errorf("%s partition is not encrypted and no encryption keys are configured", h.label)
+	}
+
+	log.Printf("encrypting partition %q with label %q", h.partition, h.label)
+
+	key, err := h.keys[0].GetKey(ctx, h.label)
+	if err != nil {
+		return fmt.Errorf("failed to get key for slot %d: %w", h.keys[0].Slot(), err)
+	}
+
+	if err = luksFormat(ctx, h.partition, key, h.keys[0].Slot(), h.cipher, h.keySize); err != nil {
+		return err
+	}
+
+	for _, k := range h.keys[1:] {
+		var newKey []byte
+
+		if newKey, err = k.GetKey(ctx, h.label); err != nil {
+			return fmt.Errorf("failed to get key for slot %d: %w", k.Slot(), err)
+		}
+
+		if err = luksAddKey(ctx, h.partition, key, newKey, k.Slot()); err != nil {
+			return err
+		}
+	}
+
+	for _, k := range h.keys {
+		if t := k.Token(); t != nil {
+			if err = luksImportToken(ctx, h.partition, t); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err = luksOpen(ctx, h.partition, h.label, key); err != nil {
+		return err
+	}
+
+	log.Printf("formatting partition %q as %q with label %q\n", MappedPath(h.label), "xfs", h.label)
+
+	return makefs.XFS(MappedPath(h.label), makefs.WithLabel(h.label), makefs.WithForce(true))
+}
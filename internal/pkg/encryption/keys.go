@@ -0,0 +1,255 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/talos-systems/go-smbios/smbios"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+// Key sources.
+const (
+	SourceStatic = "static"
+	SourceNodeID = "nodeID"
+	SourceKMS    = "kms"
+)
+
+// TokenType is the type of the LUKS2 tokens created by Talos.
+const TokenType = "talos"
+
+// Token describes where the key of a slot comes from. Tokens are stored in
+// the LUKS2 header, so that a partition can be unlocked before the machine
+// config is available.
+type Token struct {
+	Type     string   `json:"type"`
+	Keyslots []string `json:"keyslots"`
+	Source   string   `json:"source"`
+	Endpoint string   `json:"endpoint,omitempty"`
+}
+
+func parseToken(b []byte) (*Token, error) {
+	t := &Token{}
+
+	if err := json.Unmarshal(b, t); err != nil {
+		return nil, fmt.Errorf("failed to parse LUKS2 token: %w", err)
+	}
+
+	// skip the tokens created by other tools
+	if t.Type != TokenType {
+		return nil, nil
+	}
+
+	return t, nil
+}
+
+// NodeSecretFunc returns the node-unique secret the nodeID keys are derived
+// from.
+type NodeSecretFunc func() ([]byte, error)
+
+// KeyHandler provides the key of a LUKS2 key slot.
+type KeyHandler interface {
+	// Slot returns the key slot.
+	Slot() int
+	// GetKey returns the key for the partition.
+	GetKey(ctx context.Context, label string) ([]byte, error)
+	// Token returns the token describing the key, or nil if the key can't be
+	// obtained without the machine config.
+	Token() *Token
+}
+
+// NewKeyHandler creates a key handler from the config.
+func NewKeyHandler(cfg config.EncryptionKey, nodeSecret NodeSecretFunc) (KeyHandler, error) {
+	switch {
+	case cfg.Static() != nil:
+		return &staticKeyHandler{slot: cfg.Slot(), key: cfg.Static().Key()}, nil
+	case cfg.NodeID() != nil:
+		return &nodeIDKeyHandler{slot: cfg.Slot(), secret: nodeSecret}, nil
+	case cfg.KMS() != nil:
+		return &kmsKeyHandler{slot: cfg.Slot(), endpoint: cfg.KMS().Endpoint()}, nil
+	default:
+		return nil, fmt.Errorf("key in slot %d has no source", cfg.Slot())
+	}
+}
+
+// keyHandlerFromToken creates a key handler from the token stored in the
+// LUKS2 header.
+func keyHandlerFromToken(t *Token, nodeSecret NodeSecretFunc) (KeyHandler, error) {
+	if len(t.Keyslots) != 1 {
+		return nil, fmt.Errorf("token should reference exactly one key slot, got %d", len(t.Keyslots))
+	}
+
+	slot, err := strconv.Atoi(t.Keyslots[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid key slot %q in token: %w", t.Keyslots[0], err)
+	}
+
+	switch t.Source {
+	case SourceNodeID:
+		return &nodeIDKeyHandler{slot: slot, secret: nodeSecret}, nil
+	case SourceKMS:
+		return &kmsKeyHandler{slot: slot, endpoint: t.Endpoint}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key source %q in token", t.Source)
+	}
+}
+
+func newToken(slot int, source string) *Token {
+	return &Token{
+		Type:     TokenType,
+		Keyslots: []string{strconv.Itoa(slot)},
+		Source:   source,
+	}
+}
+
+type staticKeyHandler struct {
+	slot int
+	key  []byte
+}
+
+func (h *staticKeyHandler) Slot() int {
+	return h.slot
+}
+
+func (h *staticKeyHandler) GetKey(ctx context.Context, label string) ([]byte, error) {
+	return h.key, nil
+}
+
+func (h *staticKeyHandler) Token() *Token {
+	return nil
+}
+
+type nodeIDKeyHandler struct {
+	slot   int
+	secret NodeSecretFunc
+}
+
+func (h *nodeIDKeyHandler) Slot() int {
+	return h.slot
+}
+
+// GetKey derives the key from the node secret, so that each partition gets
+// a distinct key.
+func (h *nodeIDKeyHandler) GetKey(ctx context.Context, label string) ([]byte, error) {
+	if h.secret == nil {
+		return nil, fmt.Errorf("node secret is not available")
+	}
+
+	secret, err := h.secret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node secret: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(label)) //nolint: errcheck
+
+	return []byte(hex.EncodeToString(mac.Sum(nil))), nil
+}
+
+func (h *nodeIDKeyHandler) Token() *Token {
+	return newToken(h.slot, SourceNodeID)
+}
+
+// nodeUUID returns the UUID the node is identified with by the key service.
+var nodeUUID = smbiosUUID
+
+func smbiosUUID() (string, error) {
+	s, err := smbios.New()
+	if err != nil {
+		return "", err
+	}
+
+	uuid, err := s.SystemInformation().UUID()
+	if err != nil {
+		return "", err
+	}
+
+	return uuid.String(), nil
+}
+
+type kmsRequest struct {
+	Partition string `json:"partition"`
+	UUID      string `json:"uuid"`
+}
+
+type kmsResponse struct {
+	Key string `json:"key"`
+}
+
+type kmsKeyHandler struct {
+	slot     int
+	endpoint string
+}
+
+func (h *kmsKeyHandler) Slot() int {
+	return h.slot
+}
+
+// GetKey fetches the key from the key service.
+func (h *kmsKeyHandler) GetKey(ctx context.Context, label string) ([]byte, error) {
+	uuid, err := nodeUUID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node UUID: %w", err)
+	}
+
+	body, err := json.Marshal(&kmsRequest{Partition: label, UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch key from %s: %w", h.endpoint, err)
+	}
+
+	// nolint: errcheck
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch key from %s: unexpected status %d", h.endpoint, resp.StatusCode)
+	}
+
+	var response kmsResponse
+
+	if err = json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode key service response: %w", err)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(response.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode key: %w", err)
+	}
+
+	if len(key) == 0 {
+		return nil, fmt.Errorf("key service %s returned an empty key", h.endpoint)
+	}
+
+	return key, nil
+}
+
+func (h *kmsKeyHandler) Token() *Token {
+	t := newToken(h.slot, SourceKMS)
+	t.Endpoint = h.endpoint
+
+	return t
+}
@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint: testpackage
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToken(t *testing.T) {
+	b, err := json.Marshal((&kmsKeyHandler{slot: 2, endpoint: "https://kms.example.com/key"}).Token())
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"type":"talos","keyslots":["2"],"source":"kms","endpoint":"https://kms.example.com/key"}`, string(b))
+
+	token, err := parseToken(b)
+	require.NoError(t, err)
+
+	k, err := keyHandlerFromToken(token, nil)
+	require.NoError(t, err)
+	assert.Equal(t, &kmsKeyHandler{slot: 2, endpoint: "https://kms.example.com/key"}, k)
+
+	token, err = parseToken([]byte(`{"type":"systemd-tpm2","keyslots":["1"]}`))
+	require.NoError(t, err)
+	assert.Nil(t, token)
+
+	_, err = keyHandlerFromToken(&Token{Type: TokenType, Keyslots: []string{"0"}, Source: SourceStatic}, nil)
+	assert.EqualError(t, err, `unsupported key source "static" in token`)
+
+	assert.Nil(t, (&staticKeyHandler{slot: 0, key: []byte("secret")}).Token())
+}
+
+func TestNodeIDKey(t *testing.T) {
+	h := &nodeIDKeyHandler{slot: 1, secret: func() ([]byte, error) { return []byte("node secret"), nil }}
+
+	state, err := h.GetKey(context.Background(), "STATE")
+	require.NoError(t, err)
+	assert.Len(t, state, 64)
+
+	again, err := h.GetKey(context.Background(), "STATE")
+	require.NoError(t, err)
+	assert.Equal(t, state, again)
+
+	ephemeral, err := h.GetKey(context.Background(), "EPHEMERAL")
+	require.NoError(t, err)
+	assert.NotEqual(t, state, ephemeral)
+
+	_, err = (&nodeIDKeyHandler{slot: 1}).GetKey(context.Background(), "STATE")
+	assert.EqualError(t, err, "node secret is not available")
+}
+
+func TestKMSKey(t *testing.T) {
+	defer func(f func() (string, error)) { nodeUUID = f }(nodeUUID)
+
+	nodeUUID = func() (string, error) { return "4c4c4544-0042-4810-8056-b4c04f395331", nil }
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req kmsRequest
+
+		if r.Method != http.MethodPost || json.NewDecoder(r.Body).Decode(&req) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		if req.UUID != "4c4c4544-0042-4810-8056-b4c04f395331" {
+			w.WriteHeader(http.StatusForbidden)
+
+			return
+		}
+
+		// nolint: errcheck
+		json.NewEncoder(w).Encode(&kmsResponse{Key: base64.StdEncoding.EncodeToString([]byte("key for " + req.Partition))})
+	}))
+	defer ts.Close()
+
+	key, err := (&kmsKeyHandler{endpoint: ts.URL}).GetKey(context.Background(), "EPHEMERAL")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("key for EPHEMERAL"), key)
+
+	nodeUUID = func() (string, error) { return "00000000-0000-0000-0000-000000000000", nil }
+
+	_, err = (&kmsKeyHandler{endpoint: ts.URL}).GetKey(context.Background(), "EPHEMERAL")
+	assert.EqualError(t, err, "failed to fetch key from "+ts.URL+": unexpected status 403")
+}
+
+func TestIsLUKS(t *testing.T) {
+	f, err := ioutil.TempFile("", "talos")
+	require.NoError(t, err)
+
+	defer os.Remove(f.Name()) //nolint: errcheck
+
+	encrypted, err := IsLUKS(f.Name())
+	require.NoError(t, err)
+	assert.False(t, encrypted)
+
+	_, err = f.Write(append(luksMagic, 0, 2))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	encrypted, err = IsLUKS(f.Name())
+	require.NoError(t, err)
+	assert.True(t, encrypted)
+}
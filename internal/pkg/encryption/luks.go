@@ -0,0 +1,163 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/talos-systems/talos/pkg/cmd"
+)
+
+const (
+	cryptsetup = "cryptsetup"
+
+	// maxTokens is the number of the token slots in the LUKS2 header.
+	maxTokens = 32
+)
+
+// luksMagic is the magic at the beginning of the LUKS header.
+var luksMagic = []byte{'L', 'U', 'K', 'S', 0xba, 0xbe}
+
+// IsLUKS checks whether the device has a LUKS header.
+func IsLUKS(path string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+
+	// nolint: errcheck
+	defer f.Close()
+
+	magic := make([]byte, len(luksMagic))
+
+	if _, err = io.ReadFull(f, magic); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return bytes.Equal(magic, luksMagic), nil
+}
+
+// MappedPath returns the path of the device-mapper device for the name.
+func MappedPath(name string) string {
+	return filepath.Join("/dev/mapper", name)
+}
+
+func luksFormat(ctx context.Context, path string, key []byte, slot int, cipher string, keySize uint) error {
+	args := []string{"luksFormat", "--type", "luks2", "--batch-mode", "--key-file=-", "--key-slot", strconv.Itoa(slot)}
+
+	if cipher != "" {
+		args = append(args, "--cipher", cipher)
+	}
+
+	if keySize != 0 {
+		args = append(args, "--key-size", strconv.FormatUint(uint64(keySize), 10))
+	}
+
+	args = append(args, path)
+
+	if _, err := cmd.RunWithOptions(ctx, cryptsetup, args, cmd.WithStdin(bytes.NewReader(key))); err != nil {
+		return fmt.Errorf("failed to format %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// luksAddKey adds the new key to the slot, the existing key is passed on
+// stdin and the new one via a pipe so that neither ends up on the command line.
+func luksAddKey(ctx context.Context, path string, key, newKey []byte, slot int) error {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+
+	// nolint: errcheck
+	defer r.Close()
+
+	if _, err = w.Write(newKey); err != nil {
+		w.Close() //nolint: errcheck
+
+		return err
+	}
+
+	if err = w.Close(); err != nil {
+		return err
+	}
+
+	args := []string{"luksAddKey", "--batch-mode", "--key-file=-", "--key-slot", strconv.Itoa(slot), path, "/proc/self/fd/3"}
+
+	if _, err = cmd.RunWithOptions(ctx, cryptsetup, args, cmd.WithStdin(bytes.NewReader(key)), cmd.WithExtraFiles(r)); err != nil {
+		return fmt.Errorf("failed to add key to slot %d of %s: %w", slot, path, err)
+	}
+
+	return nil
+}
+
+func luksOpen(ctx context.Context, path, name string, key []byte) error {
+	args := []string{"open", "--type", "luks2", "--key-file=-", path, name}
+
+	if _, err := cmd.RunWithOptions(ctx, cryptsetup, args, cmd.WithStdin(bytes.NewReader(key))); err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func luksClose(ctx context.Context, name string) error {
+	if _, err := cmd.RunContext(ctx, cryptsetup, "close", name); err != nil {
+		return fmt.Errorf("failed to close %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func luksImportToken(ctx context.Context, path string, t *Token) error {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+
+	args := []string{"token", "import", "--json-file=-", path}
+
+	if _, err = cmd.RunWithOptions(ctx, cryptsetup, args, cmd.WithStdin(bytes.NewReader(b))); err != nil {
+		return fmt.Errorf("failed to import token to %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// luksTokens returns the Talos tokens stored in the LUKS2 header.
+func luksTokens(ctx context.Context, path string) ([]*Token, error) {
+	tokens := []*Token{}
+
+	for id := 0; id < maxTokens; id++ {
+		// cryptsetup fails to export the unused token slots
+		out, err := cmd.RunContext(ctx, cryptsetup, "token", "export", "--token-id", strconv.Itoa(id), path)
+		if err != nil {
+			continue
+		}
+
+		t, err := parseToken([]byte(out))
+		if err != nil {
+			return nil, err
+		}
+
+		if t != nil {
+			tokens = append(tokens, t)
+		}
+	}
+
+	return tokens, nil
+}
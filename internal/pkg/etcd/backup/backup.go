@@ -0,0 +1,215 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package backup takes the etcd snapshots, stores them locally and uploads
+// them to the S3-compatible object storage.
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/talos-systems/talos/internal/pkg/etcd"
+	"github.com/talos-systems/talos/internal/pkg/s3"
+)
+
+// Snapshotter takes the etcd snapshots, it is implemented by the etcd client.
+type Snapshotter interface {
+	Snapshot(ctx context.Context) (io.ReadCloser, error)
+}
+
+// Store is the object storage of the backups.
+type Store interface {
+	PutObject(ctx context.Context, key string, body io.ReadSeeker) error
+	ListObjects(ctx context.Context, prefix string) ([]s3.Object, error)
+	DeleteObject(ctx context.Context, key string) error
+}
+
+// Options configures the backup.
+type Options struct {
+	// Dir is the directory of the local backups.
+	Dir string
+	// Node is the name of the node recorded in the backup names.
+	Node string
+	// Retention is the number of the backups to keep.
+	Retention int
+
+	// Store is optional, the backups are kept only locally without it.
+	Store Store
+	// StorePrefix is the prefix of the backup object names.
+	StorePrefix string
+}
+
+const (
+	namePrefix = "etcd-"
+	nameSuffix = ".db"
+
+	timestampFormat = "20060102T150405Z"
+)
+
+// Name returns the name of the backup taken at the time, the backups are
+// sorted by the names in the order they were taken.
+func Name(node string, t time.Time) string {
+	return namePrefix + t.UTC().Format(timestampFormat) + "-" + node + nameSuffix
+}
+
+// isBackup checks whether the name is the name of the node backup.
+func isBackup(name, node string) bool {
+	nodeSuffix := "-" + node + nameSuffix
+
+	return len(name) == len(namePrefix)+len(timestampFormat)+len(nodeSuffix) &&
+		strings.HasPrefix(name, namePrefix) && strings.HasSuffix(name, nodeSuffix)
+}
+
+// Run takes the snapshot, verifies its integrity hash, and stores it. The
+// oldest backups of the node beyond the retention are deleted, the backups
+// of the other nodes sharing the store prefix are left intact.
+//
+// Run returns the path of the local backup.
+func Run(ctx context.Context, snapshotter Snapshotter, opts Options, now time.Time) (string, error) {
+	if err := os.MkdirAll(opts.Dir, 0o700); err != nil {
+		return "", err
+	}
+
+	name := Name(opts.Node, now)
+	path := filepath.Join(opts.Dir, name)
+
+	if err := save(ctx, snapshotter, path); err != nil {
+		return "", err
+	}
+
+	if err := pruneLocal(opts.Dir, opts.Node, opts.Retention); err != nil {
+		return path, err
+	}
+
+	if opts.Store == nil {
+		return path, nil
+	}
+
+	if err := upload(ctx, opts.Store, opts.StorePrefix+name, path); err != nil {
+		return path, err
+	}
+
+	return path, pruneStore(ctx, opts.Store, opts.StorePrefix, opts.Node, opts.Retention)
+}
+
+func save(ctx context.Context, snapshotter Snapshotter, path string) error {
+	partPath := path + ".part"
+
+	// nolint: errcheck
+	defer os.Remove(partPath)
+
+	f, err := os.OpenFile(partPath, os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0o600)
+	if err != nil {
+		return err
+	}
+
+	// nolint: errcheck
+	defer f.Close()
+
+	rd, err := snapshotter.Snapshot(ctx)
+	if err != nil {
+		return fmt.Errorf("error taking etcd snapshot: %w", err)
+	}
+
+	// nolint: errcheck
+	defer rd.Close()
+
+	if _, err = io.Copy(f, rd); err != nil {
+		return fmt.Errorf("error reading etcd snapshot: %w", err)
+	}
+
+	if err = etcd.VerifySnapshot(f); err != nil {
+		return fmt.Errorf("error verifying etcd snapshot: %w", err)
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(partPath, path)
+}
+
+func upload(ctx context.Context, store Store, key, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	// nolint: errcheck
+	defer f.Close()
+
+	if err = store.PutObject(ctx, key, f); err != nil {
+		return fmt.Errorf("error uploading backup %q: %w", key, err)
+	}
+
+	return nil
+}
+
+func pruneLocal(dir, node string, retention int) error {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	names := []string{}
+
+	for _, entry := range entries {
+		if entry.Mode().IsRegular() && isBackup(entry.Name(), node) {
+			names = append(names, entry.Name())
+		}
+	}
+
+	for _, name := range expired(names, retention) {
+		if err = os.Remove(filepath.Join(dir, name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func pruneStore(ctx context.Context, store Store, prefix, node string, retention int) error {
+	objects, err := store.ListObjects(ctx, prefix)
+	if err != nil {
+		return fmt.Errorf("error listing backups: %w", err)
+	}
+
+	names := []string{}
+
+	for _, object := range objects {
+		name := strings.TrimPrefix(object.Key, prefix)
+
+		// the objects in the "subdirectories" of the prefix are not the backups
+		if isBackup(name, node) && !strings.Contains(name, "/") {
+			names = append(names, name)
+		}
+	}
+
+	for _, name := range expired(names, retention) {
+		if err = store.DeleteObject(ctx, prefix+name); err != nil {
+			return fmt.Errorf("error deleting backup %q: %w", prefix+name, err)
+		}
+	}
+
+	return nil
+}
+
+// expired returns the oldest backups beyond the retention.
+func expired(names []string, retention int) []string {
+	if len(names) <= retention {
+		return nil
+	}
+
+	sort.Strings(names)
+
+	return names[:len(names)-retention]
+}
@@ -0,0 +1,102 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package backup_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/etcd/backup"
+	"github.com/talos-systems/talos/internal/pkg/s3"
+	"github.com/talos-systems/talos/internal/pkg/s3/s3test"
+)
+
+type snapshotter struct {
+	corrupted bool
+}
+
+func (s snapshotter) Snapshot(context.Context) (io.ReadCloser, error) {
+	db := bytes.Repeat([]byte{0xde, 0xad, 0xbe, 0xef}, 1024)
+	hash := sha256.Sum256(db)
+
+	if s.corrupted {
+		db[0] = 0
+	}
+
+	return ioutil.NopCloser(bytes.NewReader(append(db, hash[:]...))), nil
+}
+
+func TestRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "talos")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	signer := &s3.Signer{Region: "us-east-1", AccessKeyID: "minio", SecretAccessKey: "minio123"}
+
+	srv := s3test.NewServer("backups", signer)
+	defer srv.Close()
+
+	store, err := s3.NewClient(srv.URL, signer.Region, "backups", signer.AccessKeyID, signer.SecretAccessKey)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	require.NoError(t, store.PutObject(ctx, "cluster/readme.txt", strings.NewReader("not a backup")))
+
+	// the backups of the other nodes sharing the prefix are not pruned
+	require.NoError(t, store.PutObject(ctx, "cluster/etcd-20200101T000000Z-master-2.db", strings.NewReader("other node")))
+	require.NoError(t, store.PutObject(ctx, "cluster/etcd-20200101T000000Z-1.db", strings.NewReader("other node")))
+
+	opts := backup.Options{
+		Dir:         filepath.Join(dir, "etcd-backup"),
+		Node:        "master-1",
+		Retention:   2,
+		Store:       store,
+		StorePrefix: "cluster/",
+	}
+
+	start := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 3; i++ {
+		var path string
+
+		path, err = backup.Run(ctx, snapshotter{}, opts, start.Add(time.Duration(i)*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(opts.Dir, backup.Name("master-1", start.Add(time.Duration(i)*time.Hour))), path)
+	}
+
+	entries, err := ioutil.ReadDir(opts.Dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "etcd-20201001T130000Z-master-1.db", entries[0].Name())
+	assert.Equal(t, "etcd-20201001T140000Z-master-1.db", entries[1].Name())
+
+	assert.Equal(t, []string{
+		"cluster/etcd-20200101T000000Z-1.db",
+		"cluster/etcd-20200101T000000Z-master-2.db",
+		"cluster/etcd-20201001T130000Z-master-1.db",
+		"cluster/etcd-20201001T140000Z-master-1.db",
+		"cluster/readme.txt",
+	}, srv.Objects())
+
+	_, err = backup.Run(ctx, snapshotter{corrupted: true}, opts, start.Add(3*time.Hour))
+	assert.Error(t, err)
+
+	entries, err = ioutil.ReadDir(opts.Dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+}
@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/etcdserver/etcdserverpb"
+)
+
+// ErrQuorumLoss is returned when removing the member would leave the cluster
+// without the quorum.
+var ErrQuorumLoss = errors.New("removing the member would break the etcd quorum")
+
+// memberHealthTimeout is the timeout of the member status request.
+const memberHealthTimeout = 5 * time.Second
+
+// MemberHealth queries the status of each member, the members which don't
+// respond are reported as unhealthy.
+func MemberHealth(ctx context.Context, client *clientv3.Client, members []*etcdserverpb.Member) map[uint64]bool {
+	healthy := make(map[uint64]bool, len(members))
+
+	for _, member := range members {
+		for _, url := range member.ClientURLs {
+			statusCtx, cancel := context.WithTimeout(ctx, memberHealthTimeout)
+			_, err := client.Status(statusCtx, url)
+
+			cancel()
+
+			if err == nil {
+				healthy[member.ID] = true
+
+				break
+			}
+		}
+	}
+
+	return healthy
+}
+
+// CheckQuorum verifies that the healthy voting members are the quorum of the
+// cluster once the member is removed.
+func CheckQuorum(members []*etcdserverpb.Member, healthy map[uint64]bool, remove uint64) error {
+	var voting, healthyVoting int
+
+	for _, member := range members {
+		if member.ID == remove || member.IsLearner {
+			continue
+		}
+
+		voting++
+
+		if healthy[member.ID] {
+			healthyVoting++
+		}
+	}
+
+	if voting == 0 {
+		return fmt.Errorf("%w: the member is the last one", ErrQuorumLoss)
+	}
+
+	if quorum := voting/2 + 1; healthyVoting < quorum {
+		return fmt.Errorf("%w: %d of %d remaining members are healthy, %d are required", ErrQuorumLoss, healthyVoting, voting, quorum)
+	}
+
+	return nil
+}
+
+// FindMember finds the member by the hostname or the hex ID.
+func FindMember(members []*etcdserverpb.Member, member string) (*etcdserverpb.Member, error) {
+	for _, m := range members {
+		if m.Name == member || fmt.Sprintf("%x", m.ID) == member {
+			return m, nil
+		}
+	}
+
+	return nil, fmt.Errorf("etcd member %q is not found", member)
+}
+
+// IsLeader checks whether the local member the client is connected to is the
+// leader of the cluster.
+func IsLeader(ctx context.Context, client *clientv3.Client) (bool, error) {
+	resp, err := client.Status(ctx, client.Endpoints()[0])
+	if err != nil {
+		return false, fmt.Errorf("error getting etcd status: %w", err)
+	}
+
+	return resp.Leader == resp.Header.MemberId, nil
+}
@@ -0,0 +1,89 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/etcdserver/etcdserverpb"
+
+	"github.com/talos-systems/talos/internal/pkg/etcd"
+)
+
+func TestCheckQuorum(t *testing.T) {
+	members := []*etcdserverpb.Member{
+		{ID: 0x1, Name: "master-1"},
+		{ID: 0x2, Name: "master-2"},
+		{ID: 0x3, Name: "master-3"},
+		{ID: 0x4, Name: "master-4", IsLearner: true},
+	}
+
+	for _, tt := range []struct {
+		name    string
+		healthy map[uint64]bool
+		remove  uint64
+		ok      bool
+	}{
+		{
+			name:    "dead member",
+			healthy: map[uint64]bool{0x1: true, 0x2: true},
+			remove:  0x3,
+			ok:      true,
+		},
+		{
+			name:    "healthy member of the healthy cluster",
+			healthy: map[uint64]bool{0x1: true, 0x2: true, 0x3: true},
+			remove:  0x1,
+			ok:      true,
+		},
+		{
+			name:    "healthy member of the degraded cluster",
+			healthy: map[uint64]bool{0x1: true, 0x2: true},
+			remove:  0x1,
+		},
+		{
+			name:    "learner",
+			healthy: map[uint64]bool{0x1: true, 0x2: true},
+			remove:  0x4,
+			ok:      true,
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			err := etcd.CheckQuorum(members, tt.healthy, tt.remove)
+
+			if tt.ok {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, etcd.ErrQuorumLoss))
+			}
+		})
+	}
+
+	err := etcd.CheckQuorum(members[:1], map[uint64]bool{0x1: true}, 0x1)
+	assert.True(t, errors.Is(err, etcd.ErrQuorumLoss))
+}
+
+func TestFindMember(t *testing.T) {
+	members := []*etcdserverpb.Member{
+		{ID: 0x8e9e05c52164694d, Name: "master-1"},
+		{ID: 0x91bc3c398fb3c146, Name: "master-2"},
+	}
+
+	member, err := etcd.FindMember(members, "master-2")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0x91bc3c398fb3c146), member.ID)
+
+	member, err = etcd.FindMember(members, "8e9e05c52164694d")
+	require.NoError(t, err)
+	assert.Equal(t, "master-1", member.Name)
+
+	_, err = etcd.FindMember(members, "master-3")
+	assert.EqualError(t, err, `etcd member "master-3" is not found`)
+}
@@ -0,0 +1,35 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// DefaultQuotaBackendBytes is the space quota etcd uses when
+// quota-backend-bytes is not set.
+const DefaultQuotaBackendBytes = 2 * 1024 * 1024 * 1024
+
+// QuotaBackendBytes returns the space quota configured with the etcd extra
+// args.
+func QuotaBackendBytes(extraArgs map[string]string) (int64, error) {
+	v, ok := extraArgs["quota-backend-bytes"]
+	if !ok {
+		return DefaultQuotaBackendBytes, nil
+	}
+
+	quota, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing quota-backend-bytes: %w", err)
+	}
+
+	// etcd falls back to the default quota if it's not positive
+	if quota <= 0 {
+		return DefaultQuotaBackendBytes, nil
+	}
+
+	return quota, nil
+}
@@ -0,0 +1,31 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/etcd"
+)
+
+func TestQuotaBackendBytes(t *testing.T) {
+	quota, err := etcd.QuotaBackendBytes(nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, etcd.DefaultQuotaBackendBytes, quota)
+
+	quota, err = etcd.QuotaBackendBytes(map[string]string{"quota-backend-bytes": "8589934592"})
+	require.NoError(t, err)
+	assert.EqualValues(t, 8589934592, quota)
+
+	quota, err = etcd.QuotaBackendBytes(map[string]string{"quota-backend-bytes": "0"})
+	require.NoError(t, err)
+	assert.EqualValues(t, etcd.DefaultQuotaBackendBytes, quota)
+
+	_, err = etcd.QuotaBackendBytes(map[string]string{"quota-backend-bytes": "8G"})
+	assert.Error(t, err)
+}
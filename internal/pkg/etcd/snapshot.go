@@ -0,0 +1,54 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrNoSnapshotHash is returned for the snapshots without the integrity hash,
+// e.g. the database files copied from the etcd data directory.
+var ErrNoSnapshotHash = errors.New("snapshot has no integrity hash")
+
+// VerifySnapshot verifies the sha256 hash appended by etcd to the snapshot
+// database.
+func VerifySnapshot(r io.ReadSeeker) error {
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+
+	// etcd pads the database to the 512-byte pages, so the hash is the only
+	// reason for the size to be unaligned
+	if size%512 != sha256.Size {
+		return ErrNoSnapshotHash
+	}
+
+	if _, err = r.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	h := sha256.New()
+
+	if _, err = io.CopyN(h, r, size-sha256.Size); err != nil {
+		return fmt.Errorf("error reading snapshot: %w", err)
+	}
+
+	expected := make([]byte, sha256.Size)
+
+	if _, err = io.ReadFull(r, expected); err != nil {
+		return fmt.Errorf("error reading snapshot hash: %w", err)
+	}
+
+	if actual := h.Sum(nil); !bytes.Equal(actual, expected) {
+		return fmt.Errorf("snapshot hash mismatch: expected %x, got %x", expected, actual)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/talos-systems/talos/internal/pkg/etcd"
+)
+
+func TestVerifySnapshot(t *testing.T) {
+	db := bytes.Repeat([]byte{0xde, 0xad, 0xbe, 0xef}, 1024)
+	hash := sha256.Sum256(db)
+
+	snapshot := append(append([]byte{}, db...), hash[:]...)
+
+	assert.NoError(t, etcd.VerifySnapshot(bytes.NewReader(snapshot)))
+
+	assert.True(t, errors.Is(etcd.VerifySnapshot(bytes.NewReader(db)), etcd.ErrNoSnapshotHash))
+
+	snapshot[10] ^= 0xff
+
+	err := etcd.VerifySnapshot(bytes.NewReader(snapshot))
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, etcd.ErrNoSnapshotHash))
+}
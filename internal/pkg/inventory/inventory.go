@@ -0,0 +1,501 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package inventory probes the block devices attached to the node.
+package inventory
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/talos-systems/go-blockdevice/blockdevice/filesystem"
+	"github.com/talos-systems/go-blockdevice/blockdevice/filesystem/vfat"
+	"github.com/talos-systems/go-blockdevice/blockdevice/filesystem/xfs"
+	"github.com/talos-systems/go-blockdevice/blockdevice/lba"
+	"github.com/talos-systems/go-blockdevice/blockdevice/probe"
+	"github.com/talos-systems/go-blockdevice/blockdevice/table/gpt"
+	"github.com/talos-systems/go-blockdevice/blockdevice/table/gpt/partition"
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/internal/pkg/encryption"
+	"github.com/talos-systems/talos/internal/pkg/raid"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/disk"
+)
+
+// Partition table types.
+const (
+	TableGPT = "gpt"
+	TableMBR = "mbr"
+)
+
+// Owner describes how Talos uses the device.
+type Owner int
+
+// Device owners.
+const (
+	OwnerNone Owner = iota
+	OwnerSystem
+	OwnerUser
+)
+
+// Device is a block device attached to the node.
+type Device struct {
+	*disk.Disk
+
+	// PartitionTable is the type of the partition table, it is empty if the
+	// device has no partition table.
+	PartitionTable string
+	Partitions     []*Partition
+	// FileSystem is the filesystem created on the whole device.
+	FileSystem *FileSystem
+	Owner      Owner
+	// Error is set if the device failed to be probed, the device is listed
+	// with the details probed so far.
+	Error error
+}
+
+// Partition is a GPT or an MBR partition.
+type Partition struct {
+	DeviceName string
+	Number     int32
+	// Label, TypeGUID and UUID are set for the GPT partitions.
+	Label    string
+	TypeGUID string
+	UUID     string
+	// MBRType is set for the MBR partitions.
+	MBRType    uint8
+	Size       uint64
+	FileSystem *FileSystem
+	// Owned is set for the partitions created by Talos.
+	Owned bool
+}
+
+// FileSystem is the filesystem detected on a device or a partition.
+type FileSystem struct {
+	// Type is the filesystem type, or luks for the encrypted partitions.
+	Type  string
+	Label string
+}
+
+// systemPartitions are the partitions created by the installer.
+var systemPartitions = map[string]struct{}{
+	constants.EFIPartitionLabel:       {},
+	constants.BIOSGrubPartitionLabel:  {},
+	constants.BootPartitionLabel:      {},
+	constants.MetaPartitionLabel:      {},
+	constants.StatePartitionLabel:     {},
+	constants.EphemeralPartitionLabel: {},
+}
+
+// List probes all the block devices of the node. The devices which have no
+// media (e.g. unused loop devices) are skipped. A device which fails to be
+// probed is listed with the error, so that it doesn't hide the other devices.
+//
+// The user disks are marked as owned by the user.
+func List(userDisks ...string) ([]*Device, error) {
+	entries, err := ioutil.ReadDir("/sys/block")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list block devices: %w", err)
+	}
+
+	user := map[string]struct{}{}
+
+	for _, name := range userDisks {
+		// the user disks might be referenced by the /dev/disk/by-* links
+		if resolved, err := filepath.EvalSymlinks(name); err == nil {
+			name = resolved
+		}
+
+		user[name] = struct{}{}
+	}
+
+	devices := []*Device{}
+
+	for _, entry := range entries {
+		devpath := filepath.Join("/dev", entry.Name())
+
+		d, err := Probe(devpath)
+		if err != nil {
+			d = &Device{
+				Disk:  &disk.Disk{DeviceName: devpath},
+				Error: err,
+			}
+		}
+
+		if d.Size == 0 && d.Error == nil {
+			continue
+		}
+
+		if _, ok := user[d.DeviceName]; ok {
+			d.Owner = OwnerUser
+		}
+
+		devices = append(devices, d)
+	}
+
+	sort.Slice(devices, func(i, j int) bool { return devices[i].DeviceName < devices[j].DeviceName })
+
+	return devices, nil
+}
+
+// Probe probes the block device: its attributes, the partitions, and the
+// filesystems. The failure to read the partitions is recorded on the device.
+func Probe(devpath string) (*Device, error) {
+	attrs, err := disk.Probe(devpath)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Device{
+		Disk: attrs,
+	}
+
+	d.DeviceName = devpath
+
+	if d.Size == 0 {
+		return d, nil
+	}
+
+	if d.PartitionTable, err = partitionTableType(devpath); err != nil {
+		d.Error = err
+
+		return d, nil
+	}
+
+	switch d.PartitionTable {
+	case TableGPT:
+		d.Partitions, d.Error = probePartitions(devpath)
+	case TableMBR:
+		d.Partitions, d.Error = probeMBRPartitions(devpath)
+	default:
+		d.FileSystem = probeFileSystem(devpath)
+	}
+
+	for _, p := range d.Partitions {
+		if p.Owned {
+			d.Owner = OwnerSystem
+		}
+	}
+
+	return d, nil
+}
+
+// partitionTableType detects the partition table type by the protective MBR.
+func partitionTableType(devpath string) (string, error) {
+	f, err := os.OpenFile(devpath, os.O_RDONLY|unix.O_CLOEXEC, os.ModeDevice)
+	if err != nil {
+		return "", err
+	}
+
+	// nolint: errcheck
+	defer f.Close()
+
+	mbr := make([]byte, 512)
+
+	if _, err = f.ReadAt(mbr, 0); err != nil {
+		return "", fmt.Errorf("failed to read MBR of %s: %w", devpath, err)
+	}
+
+	switch {
+	case !bytes.Equal(mbr[510:], []byte{0x55, 0xaa}):
+		return "", nil
+	// the partition type of the protective MBR entry is 0xee (EFI GPT)
+	case mbr[450] == 0xee:
+		return TableGPT, nil
+	default:
+		return TableMBR, nil
+	}
+}
+
+func probePartitions(devpath string) ([]*Partition, error) {
+	f, err := os.OpenFile(devpath, os.O_RDONLY|unix.O_CLOEXEC, os.ModeDevice)
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer f.Close()
+
+	addresser, err := lba.New(f)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := gpt.NewGPT(devpath, f)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = g.Read(); err != nil {
+		return nil, fmt.Errorf("failed to read partition table of %s: %w", devpath, err)
+	}
+
+	partitions := []*Partition{}
+
+	for _, p := range g.Partitions() {
+		part, ok := p.(*partition.Partition)
+		if !ok {
+			continue
+		}
+
+		partpath, err := raid.PartPath(devpath, int(part.No()))
+		if err != nil {
+			return nil, err
+		}
+
+		_, owned := systemPartitions[part.Name]
+
+		partitions = append(partitions, &Partition{
+			DeviceName: partpath,
+			Number:     part.No(),
+			Label:      part.Name,
+			TypeGUID:   strings.ToUpper(part.Type.String()),
+			UUID:       part.ID.String(),
+			Size:       uint64(part.Length()) * addresser.LogicalBlockSize,
+			FileSystem: probeFileSystem(partpath),
+			Owned:      owned,
+		})
+	}
+
+	return partitions, nil
+}
+
+// MBR partition types of the extended partitions.
+var extendedPartitionTypes = map[uint8]struct{}{
+	0x05: {},
+	0x0f: {},
+	0x85: {},
+}
+
+// maxLogicalPartitions limits the chain of the extended boot records, so that
+// a corrupted chain doesn't loop.
+const maxLogicalPartitions = 128
+
+// mbrEntry is the MBR partition table entry.
+type mbrEntry struct {
+	Type    uint8
+	Start   uint32
+	Sectors uint32
+}
+
+func readMBREntries(r io.ReaderAt, offset int64) ([4]mbrEntry, error) {
+	var entries [4]mbrEntry
+
+	sector := make([]byte, 512)
+
+	if _, err := r.ReadAt(sector, offset); err != nil {
+		return entries, err
+	}
+
+	if !bytes.Equal(sector[510:], []byte{0x55, 0xaa}) {
+		return entries, fmt.Errorf("invalid boot record signature at %d", offset)
+	}
+
+	for i := range entries {
+		entry := sector[446+16*i : 446+16*(i+1)]
+
+		entries[i] = mbrEntry{
+			Type:    entry[4],
+			Start:   binary.LittleEndian.Uint32(entry[8:12]),
+			Sectors: binary.LittleEndian.Uint32(entry[12:16]),
+		}
+	}
+
+	return entries, nil
+}
+
+// mbrPartitions lists the primary partitions and the logical partitions of
+// the extended partition, the logical partitions are numbered from 5.
+func mbrPartitions(r io.ReaderAt, sectorSize int64) (map[int32]mbrEntry, error) {
+	primary, err := readMBREntries(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	partitions := map[int32]mbrEntry{}
+
+	for i, entry := range primary {
+		if entry.Type == 0 {
+			continue
+		}
+
+		if _, extended := extendedPartitionTypes[entry.Type]; !extended {
+			partitions[int32(i+1)] = entry
+
+			continue
+		}
+
+		// the logical partition is relative to its EBR, the next EBR is
+		// relative to the extended partition
+		ebr := int64(entry.Start)
+
+		for number := int32(5); number < 5+maxLogicalPartitions; number++ {
+			logical, err := readMBREntries(r, ebr*sectorSize)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read extended boot record: %w", err)
+			}
+
+			if logical[0].Type != 0 {
+				partitions[number] = mbrEntry{
+					Type:    logical[0].Type,
+					Start:   uint32(ebr) + logical[0].Start,
+					Sectors: logical[0].Sectors,
+				}
+			}
+
+			if logical[1].Type == 0 {
+				break
+			}
+
+			ebr = int64(entry.Start) + int64(logical[1].Start)
+		}
+	}
+
+	return partitions, nil
+}
+
+func probeMBRPartitions(devpath string) ([]*Partition, error) {
+	f, err := os.OpenFile(devpath, os.O_RDONLY|unix.O_CLOEXEC, os.ModeDevice)
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer f.Close()
+
+	addresser, err := lba.New(f)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := mbrPartitions(f, int64(addresser.LogicalBlockSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read partition table of %s: %w", devpath, err)
+	}
+
+	numbers := make([]int32, 0, len(entries))
+
+	for number := range entries {
+		numbers = append(numbers, number)
+	}
+
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+
+	partitions := []*Partition{}
+
+	for _, number := range numbers {
+		partpath, err := raid.PartPath(devpath, int(number))
+		if err != nil {
+			return nil, err
+		}
+
+		partitions = append(partitions, &Partition{
+			DeviceName: partpath,
+			Number:     number,
+			MBRType:    entries[number].Type,
+			Size:       uint64(entries[number].Sectors) * addresser.LogicalBlockSize,
+			FileSystem: probeFileSystem(partpath),
+		})
+	}
+
+	return partitions, nil
+}
+
+// probeFileSystem detects the filesystem, failures to read the device are
+// treated as no filesystem.
+func probeFileSystem(devpath string) *FileSystem {
+	if _, err := os.Stat(devpath); err != nil {
+		return nil
+	}
+
+	if encrypted, err := encryption.IsLUKS(devpath); err == nil && encrypted {
+		return &FileSystem{Type: "luks"}
+	}
+
+	sb, err := probe.FileSystem(devpath)
+	if err != nil || sb == nil {
+		// the probe package doesn't detect the ext filesystems
+		return probeExtFileSystem(devpath)
+	}
+
+	return &FileSystem{
+		Type:  sb.Type(),
+		Label: fileSystemLabel(sb),
+	}
+}
+
+// The ext2/3/4 superblock layout.
+const (
+	extSuperBlockOffset   = 1024
+	extSuperBlockSize     = 1024
+	extMagic              = 0xef53
+	extMagicOffset        = 0x38
+	extFeatureCompat      = 0x5c
+	extFeatureIncompat    = 0x60
+	extVolumeName         = 0x78
+	extVolumeNameSize     = 16
+	extCompatHasJournal   = 0x4
+	extIncompatExtents    = 0x40
+	extIncompat64Bit      = 0x80
+	extIncompatFlexBlocks = 0x200
+)
+
+func probeExtFileSystem(devpath string) *FileSystem {
+	f, err := os.OpenFile(devpath, os.O_RDONLY|unix.O_CLOEXEC, os.ModeDevice)
+	if err != nil {
+		return nil
+	}
+
+	// nolint: errcheck
+	defer f.Close()
+
+	sb := make([]byte, extSuperBlockSize)
+
+	if _, err = f.ReadAt(sb, extSuperBlockOffset); err != nil {
+		return nil
+	}
+
+	return parseExtSuperBlock(sb)
+}
+
+func parseExtSuperBlock(sb []byte) *FileSystem {
+	if binary.LittleEndian.Uint16(sb[extMagicOffset:]) != extMagic {
+		return nil
+	}
+
+	fs := &FileSystem{
+		Type:  "ext2",
+		Label: strings.TrimSpace(string(bytes.TrimRight(sb[extVolumeName:extVolumeName+extVolumeNameSize], "\x00"))),
+	}
+
+	switch {
+	case binary.LittleEndian.Uint32(sb[extFeatureIncompat:])&(extIncompatExtents|extIncompat64Bit|extIncompatFlexBlocks) != 0:
+		fs.Type = "ext4"
+	case binary.LittleEndian.Uint32(sb[extFeatureCompat:])&extCompatHasJournal != 0:
+		fs.Type = "ext3"
+	}
+
+	return fs
+}
+
+func fileSystemLabel(sb filesystem.SuperBlocker) string {
+	var label []byte
+
+	switch sb := sb.(type) {
+	case *xfs.SuperBlock:
+		label = sb.Fname[:]
+	case *vfat.SuperBlock:
+		label = sb.Label[:]
+	}
+
+	return strings.TrimSpace(string(bytes.TrimRight(label, "\x00")))
+}
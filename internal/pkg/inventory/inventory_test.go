@@ -0,0 +1,228 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package inventory_test
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talos-systems/go-blockdevice/blockdevice"
+	"github.com/talos-systems/go-blockdevice/blockdevice/table/gpt/partition"
+
+	"github.com/talos-systems/talos/internal/pkg/inventory"
+)
+
+const mib = 1024 * 1024
+
+var luksHeader = []byte{'L', 'U', 'K', 'S', 0xba, 0xbe, 0, 2}
+
+// setupLoopDevice attaches a sparse image of the size to a loop device.
+func setupLoopDevice(t *testing.T, size int64) string {
+	if os.Getuid() != 0 {
+		t.Skip("can't run the test as non-root")
+	}
+
+	if _, err := exec.LookPath("losetup"); err != nil {
+		t.Skip("losetup is not available, skipping the test")
+	}
+
+	f, err := ioutil.TempFile("", "talos")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { os.Remove(f.Name()) }) //nolint: errcheck
+
+	require.NoError(t, f.Truncate(size))
+	require.NoError(t, f.Close())
+
+	out, err := exec.Command("losetup", "--find", "--show", "--partscan", f.Name()).Output()
+	if err != nil {
+		t.Skipf("failed to set up a loop device: %s", err)
+	}
+
+	loop := strings.TrimSpace(string(out))
+
+	t.Cleanup(func() { exec.Command("losetup", "--detach", loop).Run() }) //nolint: errcheck
+
+	return loop
+}
+
+func writeAt(t *testing.T, path string, b []byte) {
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	require.NoError(t, err)
+
+	_, err = f.WriteAt(b, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
+
+func TestProbeGPT(t *testing.T) {
+	loop := setupLoopDevice(t, 64*mib)
+
+	bd, err := blockdevice.Open(loop, blockdevice.WithNewGPT(true))
+	require.NoError(t, err)
+
+	pt, err := bd.PartitionTable()
+	require.NoError(t, err)
+
+	_, err = pt.Add(16*mib, partition.WithPartitionName("EPHEMERAL"), partition.WithPartitionType("0FC63DAF-8483-4772-8E79-3D69D8477DE4"))
+	require.NoError(t, err)
+
+	_, err = pt.Add(8*mib, partition.WithPartitionName("data"), partition.WithPartitionType("0FC63DAF-8483-4772-8E79-3D69D8477DE4"))
+	require.NoError(t, err)
+
+	require.NoError(t, pt.Write())
+	require.NoError(t, bd.RereadPartitionTable())
+	require.NoError(t, bd.Close())
+
+	// the partition device nodes are not created without udev
+	_, err = os.Stat(loop + "p1")
+	partitionsAvailable := err == nil
+
+	if partitionsAvailable {
+		writeAt(t, loop+"p1", luksHeader)
+	}
+
+	d, err := inventory.Probe(loop)
+	require.NoError(t, err)
+
+	assert.Equal(t, loop, d.DeviceName)
+	assert.EqualValues(t, 64*mib, d.Size)
+	assert.Equal(t, inventory.TableGPT, d.PartitionTable)
+	assert.Equal(t, inventory.OwnerSystem, d.Owner)
+	assert.Nil(t, d.FileSystem)
+
+	require.Len(t, d.Partitions, 2)
+
+	assert.Equal(t, loop+"p1", d.Partitions[0].DeviceName)
+	assert.EqualValues(t, 1, d.Partitions[0].Number)
+	assert.Equal(t, "EPHEMERAL", d.Partitions[0].Label)
+	assert.Equal(t, "0FC63DAF-8483-4772-8E79-3D69D8477DE4", d.Partitions[0].TypeGUID)
+	// the partitioner might allocate an extra sector
+	assert.InDelta(t, 16*mib, d.Partitions[0].Size, 512)
+	assert.True(t, d.Partitions[0].Owned)
+
+	if partitionsAvailable {
+		assert.Equal(t, &inventory.FileSystem{Type: "luks"}, d.Partitions[0].FileSystem)
+	}
+
+	assert.Equal(t, "data", d.Partitions[1].Label)
+	assert.InDelta(t, 8*mib, d.Partitions[1].Size, 512)
+	assert.False(t, d.Partitions[1].Owned)
+	assert.Nil(t, d.Partitions[1].FileSystem)
+}
+
+func TestProbeWholeDevice(t *testing.T) {
+	loop := setupLoopDevice(t, 16*mib)
+
+	d, err := inventory.Probe(loop)
+	require.NoError(t, err)
+
+	assert.Empty(t, d.PartitionTable)
+	assert.Empty(t, d.Partitions)
+	assert.Nil(t, d.FileSystem)
+	assert.Equal(t, inventory.OwnerNone, d.Owner)
+
+	writeAt(t, loop, luksHeader)
+
+	d, err = inventory.Probe(loop)
+	require.NoError(t, err)
+
+	assert.Equal(t, &inventory.FileSystem{Type: "luks"}, d.FileSystem)
+}
+
+// bootRecord builds the MBR or EBR with the partition entries.
+func bootRecord(entries ...[3]uint32) []byte {
+	b := make([]byte, 512)
+
+	for i, entry := range entries {
+		e := b[446+16*i:]
+		e[4] = byte(entry[0])
+		binary.LittleEndian.PutUint32(e[8:], entry[1])
+		binary.LittleEndian.PutUint32(e[12:], entry[2])
+	}
+
+	b[510], b[511] = 0x55, 0xaa
+
+	return b
+}
+
+func TestProbeMBR(t *testing.T) {
+	loop := setupLoopDevice(t, 16*mib)
+
+	f, err := os.OpenFile(loop, os.O_WRONLY, 0)
+	require.NoError(t, err)
+
+	// a primary partition, and an extended partition with two logical ones
+	_, err = f.WriteAt(bootRecord([3]uint32{0x83, 2048, 8192}, [3]uint32{0x05, 16384, 8192}), 0)
+	require.NoError(t, err)
+
+	_, err = f.WriteAt(bootRecord([3]uint32{0x83, 2048, 2048}, [3]uint32{0x05, 4096, 2048}), 16384*512)
+	require.NoError(t, err)
+
+	_, err = f.WriteAt(bootRecord([3]uint32{0x82, 2048, 1024}), 20480*512)
+	require.NoError(t, err)
+
+	require.NoError(t, f.Close())
+
+	d, err := inventory.Probe(loop)
+	require.NoError(t, err)
+
+	assert.NoError(t, d.Error)
+	assert.Equal(t, inventory.TableMBR, d.PartitionTable)
+	assert.Equal(t, inventory.OwnerNone, d.Owner)
+
+	require.Len(t, d.Partitions, 3)
+
+	for i, expected := range []struct {
+		number  int32
+		mbrType uint8
+		size    uint64
+	}{
+		{1, 0x83, 4 * mib},
+		{5, 0x83, mib},
+		{6, 0x82, mib / 2},
+	} {
+		assert.Equal(t, expected.number, d.Partitions[i].Number)
+		assert.Equal(t, expected.mbrType, d.Partitions[i].MBRType)
+		assert.EqualValues(t, expected.size, d.Partitions[i].Size)
+		assert.Empty(t, d.Partitions[i].TypeGUID)
+		assert.False(t, d.Partitions[i].Owned)
+	}
+}
+
+func TestProbeCorruptedGPT(t *testing.T) {
+	loop := setupLoopDevice(t, 16*mib)
+
+	// the protective MBR without the GPT header
+	writeAt(t, loop, bootRecord([3]uint32{0xee, 1, 32767}))
+
+	d, err := inventory.Probe(loop)
+	require.NoError(t, err)
+
+	assert.Equal(t, inventory.TableGPT, d.PartitionTable)
+	assert.Error(t, d.Error)
+	assert.EqualValues(t, 16*mib, d.Size)
+}
+
+func TestProbeExt4(t *testing.T) {
+	loop := setupLoopDevice(t, 16*mib)
+
+	if _, err := exec.LookPath("mkfs.ext4"); err != nil {
+		t.Skip("mkfs.ext4 is not available, skipping the test")
+	}
+
+	require.NoError(t, exec.Command("mkfs.ext4", "-q", "-L", "data", loop).Run())
+
+	d, err := inventory.Probe(loop)
+	require.NoError(t, err)
+
+	assert.Equal(t, &inventory.FileSystem{Type: "ext4", Label: "data"}, d.FileSystem)
+}
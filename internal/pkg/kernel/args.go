@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package kernel manages the kernel arguments of the installation.
+package kernel
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/talos-systems/go-procfs/procfs"
+
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// UpdateArgs sets and removes the kernel args. The args to set are in the
+// `key=value` or `key` form, they replace all the values of the key. The args
+// to remove are the keys.
+func UpdateArgs(current string, set, remove []string) string {
+	cmdline := procfs.NewCmdline(current)
+
+	for _, key := range remove {
+		cmdline.Parameters = removeKey(cmdline.Parameters, key)
+	}
+
+	updated := procfs.NewCmdline("")
+
+	// nolint: errcheck
+	updated.AppendAll(set)
+
+	for _, param := range updated.Parameters {
+		replaced := false
+
+		for i := range cmdline.Parameters {
+			if cmdline.Parameters[i].Key() == param.Key() {
+				cmdline.Parameters[i] = param
+				replaced = true
+			}
+		}
+
+		if !replaced {
+			cmdline.Parameters = append(cmdline.Parameters, param)
+		}
+	}
+
+	return cmdline.String()
+}
+
+// MergeUpdates merges the kernel args updates into the previous updates, so
+// that all of them can be applied with UpdateArgs at once.
+func MergeUpdates(set, remove, newSet, newRemove []string) (mergedSet, mergedRemove []string) {
+	mergedSet = strings.Fields(UpdateArgs(strings.Join(set, " "), newSet, newRemove))
+
+	updated := procfs.NewCmdline("")
+
+	// nolint: errcheck
+	updated.AppendAll(newSet)
+
+	for _, key := range append(append([]string(nil), remove...), newRemove...) {
+		if updated.Get(key) != nil || contains(mergedRemove, key) {
+			continue
+		}
+
+		mergedRemove = append(mergedRemove, key)
+	}
+
+	return mergedSet, mergedRemove
+}
+
+// ValidateArgs checks that the args required by Talos are present and set to
+// the default values.
+func ValidateArgs(args string) error {
+	cmdline := procfs.NewCmdline(args)
+
+	if cmdline.Get(constants.KernelParamPlatform).First() == nil {
+		return fmt.Errorf("kernel arg %q is required", constants.KernelParamPlatform)
+	}
+
+	for _, required := range procfs.NewDefaultCmdline().Parameters {
+		param := cmdline.Get(required.Key())
+		if param == nil {
+			return fmt.Errorf("kernel arg %q is required", required.Key())
+		}
+
+		for i := 0; required.Get(i) != nil; i++ {
+			if !param.Contains(*required.Get(i)) {
+				return fmt.Errorf("kernel arg %q should be set to %q", required.Key(), *required.Get(i))
+			}
+		}
+	}
+
+	return nil
+}
+
+func removeKey(params procfs.Parameters, key string) procfs.Parameters {
+	result := params[:0]
+
+	for _, param := range params {
+		if param.Key() != key {
+			result = append(result, param)
+		}
+	}
+
+	return result
+}
+
+func contains(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,49 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kernel_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/talos-systems/go-procfs/procfs"
+
+	"github.com/talos-systems/talos/internal/pkg/kernel"
+)
+
+func TestUpdateArgs(t *testing.T) {
+	current := "talos.platform=metal console=tty0 console=ttyS0 quiet"
+
+	assert.Equal(t, "talos.platform=metal console=ttyS1,115200n8 quiet", kernel.UpdateArgs(current, []string{"console=ttyS1,115200n8"}, nil))
+	assert.Equal(t, "talos.platform=metal console=tty0 console=ttyS0 quiet net.ifnames=0", kernel.UpdateArgs(current, []string{"net.ifnames=0"}, nil))
+	assert.Equal(t, "talos.platform=metal quiet", kernel.UpdateArgs(current, nil, []string{"console"}))
+	assert.Equal(t, "talos.platform=metal console=tty0 console=tty1", kernel.UpdateArgs(current, []string{"console=tty0", "console=tty1"}, []string{"quiet"}))
+}
+
+func TestMergeUpdates(t *testing.T) {
+	set, remove := kernel.MergeUpdates(nil, nil, []string{"console=ttyS0"}, []string{"quiet"})
+	assert.Equal(t, []string{"console=ttyS0"}, set)
+	assert.Equal(t, []string{"quiet"}, remove)
+
+	set, remove = kernel.MergeUpdates(set, remove, []string{"quiet", "net.ifnames=0"}, []string{"console"})
+	assert.Equal(t, []string{"quiet", "net.ifnames=0"}, set)
+	assert.Equal(t, []string{"console"}, remove)
+
+	current := "talos.platform=metal console=tty0 quiet"
+
+	assert.Equal(t, "talos.platform=metal quiet net.ifnames=0", kernel.UpdateArgs(current, set, remove))
+}
+
+func TestValidateArgs(t *testing.T) {
+	cmdline := procfs.NewCmdline("talos.platform=metal")
+	cmdline.AppendDefaults()
+
+	assert.NoError(t, kernel.ValidateArgs(cmdline.String()))
+	assert.NoError(t, kernel.ValidateArgs(kernel.UpdateArgs(cmdline.String(), []string{"console=ttyS0"}, nil)))
+
+	assert.EqualError(t, kernel.ValidateArgs(kernel.UpdateArgs(cmdline.String(), nil, []string{"talos.platform"})), `kernel arg "talos.platform" is required`)
+	assert.EqualError(t, kernel.ValidateArgs(kernel.UpdateArgs(cmdline.String(), nil, []string{"slab_nomerge"})), `kernel arg "slab_nomerge" is required`)
+	assert.EqualError(t, kernel.ValidateArgs(kernel.UpdateArgs(cmdline.String(), []string{"pti=off"}, nil)), `kernel arg "pti" should be set to "on"`)
+}
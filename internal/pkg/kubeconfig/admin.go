@@ -5,9 +5,15 @@
 package kubeconfig
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	stdlibx509 "crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/base64"
+	"encoding/pem"
 	"fmt"
 	"io"
+	"regexp"
 	"text/template"
 	"time"
 
@@ -17,7 +23,7 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
-const adminKubeConfigTemplate = `apiVersion: v1
+const kubeConfigTemplate = `apiVersion: v1
 kind: Config
 clusters:
 - name: {{ .Cluster }}
@@ -25,22 +31,105 @@ clusters:
     server: {{ .Server }}
     certificate-authority-data: {{ .CACert }}
 users:
-- name: admin@{{ .Cluster }}
+- name: {{ .User }}@{{ .Cluster }}
   user:
-    client-certificate-data: {{ .AdminCert }}
-    client-key-data: {{ .AdminKey }}
+    client-certificate-data: {{ .Cert }}
+    client-key-data: {{ .Key }}
 contexts:
 - context:
     cluster: {{ .Cluster }}
     namespace: default
-    user: admin@{{ .Cluster }}
-  name: admin@{{ .Cluster }}
-current-context: admin@{{ .Cluster }}
+    user: {{ .User }}@{{ .Cluster }}
+  name: {{ .User }}@{{ .Cluster }}
+current-context: {{ .User }}@{{ .Cluster }}
 `
 
+// usernameRegexp matches the usernames which are safe to put into the
+// kubeconfig template as the plain YAML scalars.
+var usernameRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._:@+-]*$`)
+
+// Options for the generated kubeconfig.
+type Options struct {
+	// Username is the common name of the client certificate, the admin
+	// kubeconfig is generated if it's empty.
+	Username string
+	// Groups are the organizations of the client certificate, they can be
+	// set only along with the username.
+	Groups []string
+	// TTL is the lifetime of the client certificate, it defaults to and
+	// can't exceed the admin kubeconfig certificate lifetime.
+	TTL time.Duration
+}
+
+// Option sets the kubeconfig options.
+type Option func(*Options)
+
+// WithUsername sets the username of the kubeconfig.
+func WithUsername(username string) Option {
+	return func(o *Options) {
+		o.Username = username
+	}
+}
+
+// WithGroups sets the groups of the kubeconfig user.
+func WithGroups(groups ...string) Option {
+	return func(o *Options) {
+		o.Groups = append(o.Groups, groups...)
+	}
+}
+
+// WithTTL sets the lifetime of the kubeconfig.
+func WithTTL(ttl time.Duration) Option {
+	return func(o *Options) {
+		o.TTL = ttl
+	}
+}
+
 // GenerateAdmin generates admin kubeconfig for the cluster.
 func GenerateAdmin(config config.ClusterConfig, out io.Writer) error {
-	tpl, err := template.New("kubeconfig").Parse(adminKubeConfigTemplate)
+	return Generate(config, out)
+}
+
+// Generate generates the kubeconfig for the cluster signed by the cluster CA.
+//
+// The admin kubeconfig is generated unless the username is set, the user is
+// authorized by the RBAC bindings of the username and the groups.
+func Generate(config config.ClusterConfig, out io.Writer, setters ...Option) error {
+	maxTTL := config.AdminKubeconfig().CertLifetime()
+
+	opts := Options{
+		TTL: maxTTL,
+	}
+
+	for _, setter := range setters {
+		setter(&opts)
+	}
+
+	user := "admin"
+	commonName := constants.KubernetesAdminCertCommonName
+	groups := []string{constants.KubernetesAdminCertOrganization}
+
+	if opts.Username != "" {
+		if !usernameRegexp.MatchString(opts.Username) {
+			return fmt.Errorf("invalid kubeconfig username %q", opts.Username)
+		}
+
+		user = opts.Username
+		commonName = opts.Username
+		groups = opts.Groups
+	} else if len(opts.Groups) > 0 {
+		return fmt.Errorf("groups can't be set without the username")
+	}
+
+	if opts.TTL <= 0 {
+		return fmt.Errorf("kubeconfig TTL should be positive, got %s", opts.TTL)
+	}
+
+	if opts.TTL > maxTTL {
+		return fmt.Errorf("kubeconfig TTL can't exceed the admin kubeconfig lifetime %s, got %s", maxTTL, opts.TTL)
+	}
+
+	tpl, err := template.New("kubeconfig").Parse(kubeConfigTemplate)
 	if err != nil {
 		return fmt.Errorf("error parsing kubeconfig template: %w", err)
 	}
@@ -55,28 +144,70 @@ func GenerateAdmin(config config.ClusterConfig, out io.Writer) error {
 		return fmt.Errorf("error parseing Kubernetes key: %w", err)
 	}
 
-	adminCert, err := x509.NewCertficateAndKey(k8sCA, k8sKey,
-		x509.RSA(true),
-		x509.CommonName(constants.KubernetesAdminCertCommonName),
-		x509.Organization(constants.KubernetesAdminCertOrganization),
-		x509.NotAfter(time.Now().Add(config.AdminKubeconfig().CertLifetime())))
+	cert, key, err := newClientCertificate(k8sCA, k8sKey, commonName, groups, time.Now().Add(opts.TTL))
 	if err != nil {
-		return fmt.Errorf("error generating admin certificate: %w", err)
+		return fmt.Errorf("error generating client certificate: %w", err)
 	}
 
 	input := struct {
-		Cluster   string
-		CACert    string
-		AdminCert string
-		AdminKey  string
-		Server    string
+		Cluster string
+		CACert  string
+		User    string
+		Cert    string
+		Key     string
+		Server  string
 	}{
-		Cluster:   config.Name(),
-		CACert:    base64.StdEncoding.EncodeToString(config.CA().Crt),
-		AdminCert: base64.StdEncoding.EncodeToString(adminCert.Crt),
-		AdminKey:  base64.StdEncoding.EncodeToString(adminCert.Key),
-		Server:    config.Endpoint().String(),
+		Cluster: config.Name(),
+		CACert:  base64.StdEncoding.EncodeToString(config.CA().Crt),
+		User:    user,
+		Cert:    base64.StdEncoding.EncodeToString(cert),
+		Key:     base64.StdEncoding.EncodeToString(key),
+		Server:  config.Endpoint().String(),
 	}
 
 	return tpl.Execute(out, input)
 }
+
+// newClientCertificate issues the client certificate signed by the CA.
+//
+// Kubernetes reads the groups from the organizations of the certificate, the
+// CSR is built here as x509.CertificateRequest supports a single organization.
+func newClientCertificate(ca *stdlibx509.Certificate, caKey *rsa.PrivateKey, commonName string, groups []string, notAfter time.Time) (crtPEM, keyPEM []byte, err error) {
+	key, err := x509.NewRSAKey()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create RSA key: %w", err)
+	}
+
+	block, _ := pem.Decode(key.KeyPEM)
+	if block == nil {
+		return nil, nil, fmt.Errorf("failed to decode PEM encoded key")
+	}
+
+	priv, err := stdlibx509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse RSA private key: %w", err)
+	}
+
+	csrDER, err := stdlibx509.CreateCertificateRequest(rand.Reader, &stdlibx509.CertificateRequest{
+		SignatureAlgorithm: stdlibx509.SHA512WithRSA,
+		Subject: pkix.Name{
+			CommonName:   commonName,
+			Organization: groups,
+		},
+	}, priv)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create CSR: %w", err)
+	}
+
+	csr, err := stdlibx509.ParseCertificateRequest(csrDER)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse CSR: %w", err)
+	}
+
+	crt, err := x509.NewCertificateFromCSR(ca, caKey, csr, x509.NotAfter(notAfter))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return crt.X509CertificatePEM, key.KeyPEM, nil
+}
@@ -6,6 +6,8 @@ package kubeconfig_test
 
 import (
 	"bytes"
+	stdlibx509 "crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"net/url"
 	"testing"
@@ -24,14 +26,14 @@ type AdminSuite struct {
 	suite.Suite
 }
 
-func (suite *AdminSuite) TestGenerate() {
+func (suite *AdminSuite) clusterConfig() *v1alpha1.ClusterConfig {
 	ca, err := x509.NewSelfSignedCertificateAuthority(x509.RSA(true))
 	suite.Require().NoError(err)
 
 	u, err := url.Parse("http://localhost:3333/api")
 	suite.Require().NoError(err)
 
-	cfg := &v1alpha1.ClusterConfig{
+	return &v1alpha1.ClusterConfig{
 		ClusterName: "talos1",
 		ClusterCA: &x509.PEMEncodedCertificateAndKey{
 			Crt: ca.CrtPEM,
@@ -46,6 +48,10 @@ func (suite *AdminSuite) TestGenerate() {
 			AdminKubeconfigCertLifetime: time.Hour,
 		},
 	}
+}
+
+func (suite *AdminSuite) TestGenerate() {
+	cfg := suite.clusterConfig()
 
 	var buf bytes.Buffer
 
@@ -58,6 +64,56 @@ func (suite *AdminSuite) TestGenerate() {
 	suite.Assert().NoError(clientcmd.ConfirmUsable(*config, fmt.Sprintf("admin@%s", cfg.ClusterName)))
 }
 
+func (suite *AdminSuite) TestGenerateScoped() {
+	cfg := suite.clusterConfig()
+
+	var buf bytes.Buffer
+
+	suite.Require().NoError(kubeconfig.Generate(cfg, &buf,
+		kubeconfig.WithUsername("break-glass"),
+		kubeconfig.WithGroups("ops", "readers"),
+		kubeconfig.WithTTL(15*time.Minute),
+	))
+
+	config, err := clientcmd.Load(buf.Bytes())
+	suite.Require().NoError(err)
+
+	contextName := fmt.Sprintf("break-glass@%s", cfg.ClusterName)
+
+	suite.Require().NoError(clientcmd.ConfirmUsable(*config, contextName))
+
+	block, _ := pem.Decode(config.AuthInfos[contextName].ClientCertificateData)
+	suite.Require().NotNil(block)
+
+	crt, err := stdlibx509.ParseCertificate(block.Bytes)
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal("break-glass", crt.Subject.CommonName)
+	suite.Assert().Equal([]string{"ops", "readers"}, crt.Subject.Organization)
+	suite.Assert().WithinDuration(time.Now().Add(15*time.Minute), crt.NotAfter, time.Minute)
+
+	suite.Assert().EqualError(kubeconfig.Generate(cfg, &buf, kubeconfig.WithGroups("readers")), "groups can't be set without the username")
+	suite.Assert().EqualError(kubeconfig.Generate(cfg, &buf, kubeconfig.WithTTL(-time.Hour)), "kubeconfig TTL should be positive, got -1h0m0s")
+	suite.Assert().EqualError(kubeconfig.Generate(cfg, &buf, kubeconfig.WithTTL(2*time.Hour)), "kubeconfig TTL can't exceed the admin kubeconfig lifetime 1h0m0s, got 2h0m0s")
+
+	for _, username := range []string{"ops: true", "ops\nkind: Secret", "@ops", "-ops"} {
+		suite.Assert().EqualError(kubeconfig.Generate(cfg, &buf, kubeconfig.WithUsername(username)), fmt.Sprintf("invalid kubeconfig username %q", username))
+	}
+}
+
+func (suite *AdminSuite) TestGenerateServiceAccountUsername() {
+	cfg := suite.clusterConfig()
+
+	var buf bytes.Buffer
+
+	suite.Require().NoError(kubeconfig.Generate(cfg, &buf, kubeconfig.WithUsername("system:serviceaccount:kube-system:backup")))
+
+	config, err := clientcmd.Load(buf.Bytes())
+	suite.Require().NoError(err)
+
+	suite.Assert().NoError(clientcmd.ConfirmUsable(*config, fmt.Sprintf("system:serviceaccount:kube-system:backup@%s", cfg.ClusterName)))
+}
+
 func TestAdminSuite(t *testing.T) {
 	suite.Run(t, new(AdminSuite))
 }
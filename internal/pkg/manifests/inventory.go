@@ -0,0 +1,146 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package manifests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	inventoryNamespace = "kube-system"
+	inventoryName      = "talos-manifests"
+	inventoryKey       = "inventory"
+)
+
+// Object is the reference to the object applied from the manifest.
+type Object struct {
+	Manifest   string `json:"manifest"`
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+func newObject(manifest string, obj *unstructured.Unstructured) Object {
+	return Object{
+		Manifest:   manifest,
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+// String implements fmt.Stringer.
+func (o Object) String() string {
+	if o.Namespace == "" {
+		return fmt.Sprintf("%s/%s", o.Kind, o.Name)
+	}
+
+	return fmt.Sprintf("%s/%s/%s", o.Kind, o.Namespace, o.Name)
+}
+
+// key identifies the object regardless of the API version and the manifest,
+// so that the object moved between the manifests isn't pruned.
+func (o Object) key() string {
+	return fmt.Sprintf("%s/%s", schema.FromAPIVersionAndKind(o.APIVersion, o.Kind).GroupKind(), o.String())
+}
+
+// prunable returns the inventory objects which are no longer applied.
+//
+// The objects of the failed manifests are kept, as the set of the objects is
+// unknown.
+func prunable(inventory, applied []Object, failed map[string]bool) []Object {
+	keys := make(map[string]struct{}, len(applied))
+
+	for _, obj := range applied {
+		keys[obj.key()] = struct{}{}
+	}
+
+	var result []Object
+
+	for _, obj := range inventory {
+		if failed[obj.Manifest] {
+			continue
+		}
+
+		if _, ok := keys[obj.key()]; !ok {
+			result = append(result, obj)
+		}
+	}
+
+	return result
+}
+
+// loadInventory returns the objects applied by the previous sync.
+func loadInventory(ctx context.Context, clientset kubernetes.Interface) ([]Object, error) {
+	cm, err := clientset.CoreV1().ConfigMaps(inventoryNamespace).Get(ctx, inventoryName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error loading manifest inventory: %w", err)
+	}
+
+	var inventory []Object
+
+	if err = json.Unmarshal([]byte(cm.Data[inventoryKey]), &inventory); err != nil {
+		return nil, fmt.Errorf("error decoding manifest inventory: %w", err)
+	}
+
+	return inventory, nil
+}
+
+// saveInventory stores the applied objects, so that they are pruned once
+// they are removed from the manifests.
+func saveInventory(ctx context.Context, clientset kubernetes.Interface, inventory []Object) error {
+	data, err := json.Marshal(inventory)
+	if err != nil {
+		return err
+	}
+
+	configMaps := clientset.CoreV1().ConfigMaps(inventoryNamespace)
+
+	cm, err := configMaps.Get(ctx, inventoryName, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error loading manifest inventory: %w", err)
+		}
+
+		_, err = configMaps.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      inventoryName,
+				Namespace: inventoryNamespace,
+			},
+			Data: map[string]string{
+				inventoryKey: string(data),
+			},
+		}, metav1.CreateOptions{})
+	} else {
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+
+		cm.Data[inventoryKey] = string(data)
+
+		_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+	}
+
+	if err != nil {
+		return fmt.Errorf("error saving manifest inventory: %w", err)
+	}
+
+	return nil
+}
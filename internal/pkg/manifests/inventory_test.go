@@ -0,0 +1,63 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package manifests //nolint: testpackage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPrunable(t *testing.T) {
+	inventory := []Object{
+		{Manifest: "cni", APIVersion: "apps/v1", Kind: "DaemonSet", Namespace: "kube-system", Name: "flannel"},
+		{Manifest: "cni", APIVersion: "v1", Kind: "ConfigMap", Namespace: "kube-system", Name: "flannel"},
+		{Manifest: "ci", APIVersion: "v1", Kind: "Namespace", Name: "ci"},
+		{Manifest: "monitoring", APIVersion: "v1", Kind: "Namespace", Name: "monitoring"},
+	}
+
+	applied := []Object{
+		// moved to another manifest
+		{Manifest: "ci", APIVersion: "apps/v1", Kind: "DaemonSet", Namespace: "kube-system", Name: "flannel"},
+		// same kind in the other namespace
+		{Manifest: "cni", APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "flannel"},
+	}
+
+	assert.Equal(t, []Object{
+		{Manifest: "cni", APIVersion: "v1", Kind: "ConfigMap", Namespace: "kube-system", Name: "flannel"},
+		{Manifest: "ci", APIVersion: "v1", Kind: "Namespace", Name: "ci"},
+	}, prunable(inventory, applied, map[string]bool{"monitoring": true}))
+
+	assert.Equal(t, "ConfigMap/kube-system/flannel", inventory[1].String())
+	assert.Equal(t, "Namespace/ci", inventory[2].String())
+}
+
+func TestInventory(t *testing.T) {
+	ctx := context.Background()
+	clientset := fake.NewSimpleClientset()
+
+	inventory, err := loadInventory(ctx, clientset)
+	require.NoError(t, err)
+	assert.Empty(t, inventory)
+
+	for _, expected := range [][]Object{
+		{
+			{Manifest: "ci", APIVersion: "v1", Kind: "Namespace", Name: "ci"},
+		},
+		{
+			{Manifest: "ci", APIVersion: "v1", Kind: "Namespace", Name: "ci"},
+			{Manifest: "ci", APIVersion: "v1", Kind: "ConfigMap", Namespace: "ci", Name: "settings"},
+		},
+	} {
+		require.NoError(t, saveInventory(ctx, clientset, expected))
+
+		inventory, err = loadInventory(ctx, clientset)
+		require.NoError(t, err)
+		assert.Equal(t, expected, inventory)
+	}
+}
@@ -0,0 +1,145 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package manifests keeps the manifests declared in the machine config applied
+// to the cluster.
+package manifests
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+// ErrChecksumMismatch is returned when the manifest fetched from the URL
+// doesn't match the checksum.
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// Manifest is the set of objects applied to the cluster.
+type Manifest struct {
+	Name    string
+	Objects []*unstructured.Unstructured
+}
+
+// Load fetches and parses the manifest.
+func Load(ctx context.Context, manifest config.Manifest) (Manifest, error) {
+	data, err := Fetch(ctx, manifest, nil)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	objects, err := Parse(data)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	return Manifest{
+		Name:    manifest.Name(),
+		Objects: objects,
+	}, nil
+}
+
+// Fetch returns the contents of the inline manifest or fetches the manifest
+// from the URL with the headers verifying the checksum.
+func Fetch(ctx context.Context, manifest config.Manifest, headers map[string]string) ([]byte, error) {
+	if manifest.URL() == "" {
+		return []byte(manifest.Contents()), nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, manifest.URL(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range headers {
+		req.Header.Add(k, v)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching %q: %w", manifest.URL(), err)
+	}
+
+	// nolint: errcheck
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error fetching %q: %s", manifest.URL(), resp.Status)
+	}
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching %q: %w", manifest.URL(), err)
+	}
+
+	checksum := sha256.Sum256(data)
+
+	if actual := hex.EncodeToString(checksum[:]); actual != manifest.SHA256() {
+		return nil, fmt.Errorf("%w for %q: expected %s, got %s", ErrChecksumMismatch, manifest.URL(), manifest.SHA256(), actual)
+	}
+
+	return data, nil
+}
+
+// Parse decodes the objects of the multi-document YAML or JSON manifest.
+//
+// The namespaces and the custom resource definitions are ordered first, so
+// that the objects depending on them are applied afterwards.
+func Parse(data []byte) ([]*unstructured.Unstructured, error) {
+	var objects []*unstructured.Unstructured
+
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+
+	for {
+		obj := &unstructured.Unstructured{}
+
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, fmt.Errorf("error decoding manifest: %w", err)
+		}
+
+		// empty documents
+		if len(obj.Object) == 0 {
+			continue
+		}
+
+		if obj.GetAPIVersion() == "" || obj.GetKind() == "" || obj.GetName() == "" {
+			return nil, fmt.Errorf("object %d of the manifest should have apiVersion, kind and name", len(objects)+1)
+		}
+
+		objects = append(objects, obj)
+	}
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		return applyOrder(objects[i]) < applyOrder(objects[j])
+	})
+
+	return objects, nil
+}
+
+func applyOrder(obj *unstructured.Unstructured) int {
+	switch obj.GetKind() {
+	case "Namespace":
+		return 0
+	case "CustomResourceDefinition":
+		return 1
+	default:
+		return 2
+	}
+}
@@ -0,0 +1,80 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package manifests_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/manifests"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+const manifest = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+  namespace: ci
+data:
+  key: value
+---
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: ci
+`
+
+func TestParse(t *testing.T) {
+	objects, err := manifests.Parse([]byte(manifest))
+	require.NoError(t, err)
+
+	require.Len(t, objects, 2)
+	assert.Equal(t, "Namespace", objects[0].GetKind())
+	assert.Equal(t, "ConfigMap", objects[1].GetKind())
+	assert.Equal(t, "ci", objects[1].GetNamespace())
+
+	_, err = manifests.Parse([]byte("apiVersion: v1\nkind: ConfigMap\n"))
+	assert.EqualError(t, err, "object 1 of the manifest should have apiVersion, kind and name")
+}
+
+func TestFetch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(manifest)) //nolint: errcheck
+	}))
+	defer srv.Close()
+
+	checksum := sha256.Sum256([]byte(manifest))
+
+	data, err := manifests.Fetch(context.Background(), &v1alpha1.ManifestConfig{
+		ManifestName:   "ci",
+		ManifestURL:    srv.URL,
+		ManifestSHA256: hex.EncodeToString(checksum[:]),
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, manifest, string(data))
+
+	_, err = manifests.Fetch(context.Background(), &v1alpha1.ManifestConfig{
+		ManifestName:   "ci",
+		ManifestURL:    srv.URL,
+		ManifestSHA256: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+	}, nil)
+	assert.True(t, errors.Is(err, manifests.ErrChecksumMismatch))
+
+	data, err = manifests.Fetch(context.Background(), &v1alpha1.ManifestConfig{
+		ManifestName:     "ci",
+		ManifestContents: manifest,
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, manifest, string(data))
+}
@@ -0,0 +1,296 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package manifests
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+// fieldManager owns the fields applied from the manifests.
+const fieldManager = "talos"
+
+// Action is the change of the object made by the sync.
+type Action int
+
+// Actions.
+const (
+	Created Action = iota
+	// Updated means that the object drifted from the manifest.
+	Updated
+	Pruned
+	Failed
+)
+
+// Change is the result of the sync for the object, or for the whole manifest
+// if it failed to load.
+type Change struct {
+	Object Object
+	Action Action
+	Err    error
+}
+
+// Syncer applies the manifests with the server-side apply and prunes the
+// objects removed from the manifests.
+type Syncer struct {
+	clientset kubernetes.Interface
+	dynamic   dynamic.Interface
+	mapper    resettableRESTMapper
+}
+
+// resettableRESTMapper is the RESTMapper with the discovery cache which can
+// be refreshed.
+type resettableRESTMapper interface {
+	meta.RESTMapper
+	Reset()
+}
+
+// NewSyncer initializes the Syncer.
+func NewSyncer(restConfig *restclient.Config) (*Syncer, error) {
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Syncer{
+		clientset: clientset,
+		dynamic:   dynamicClient,
+		mapper:    restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(clientset.Discovery())),
+	}, nil
+}
+
+// Sync applies the manifests in the order of the list.
+//
+// The failures of the manifests are returned as the changes, the objects of
+// the failed manifests are never pruned. The error is returned if the
+// inventory of the applied objects can't be loaded or saved.
+//
+//nolint: gocyclo
+func (s *Syncer) Sync(ctx context.Context, manifests []config.Manifest, prune bool) ([]Change, error) {
+	inventory, err := loadInventory(ctx, s.clientset)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		changes []Change
+		applied []Object
+	)
+
+	failed := map[string]bool{}
+
+	for _, m := range manifests {
+		manifest, err := Load(ctx, m)
+		if err != nil {
+			changes = append(changes, Change{Object: Object{Manifest: m.Name()}, Action: Failed, Err: err})
+			failed[m.Name()] = true
+
+			continue
+		}
+
+		for _, obj := range manifest.Objects {
+			ref, action, err := s.apply(ctx, manifest.Name, obj)
+
+			applied = append(applied, ref)
+
+			switch {
+			case err != nil:
+				changes = append(changes, Change{Object: ref, Action: Failed, Err: err})
+				failed[m.Name()] = true
+			case action != nil:
+				changes = append(changes, Change{Object: ref, Action: *action})
+			}
+		}
+	}
+
+	remove := prunable(inventory, applied, failed)
+
+	// the objects of the failed manifests are tracked until the manifest is
+	// applied again
+	newInventory := append([]Object(nil), applied...)
+
+	for _, obj := range inventory {
+		if failed[obj.Manifest] {
+			newInventory = append(newInventory, obj)
+		}
+	}
+
+	if prune {
+		for i := len(remove) - 1; i >= 0; i-- {
+			obj := remove[i]
+
+			if err := s.delete(ctx, obj); err != nil {
+				changes = append(changes, Change{Object: obj, Action: Failed, Err: fmt.Errorf("error pruning: %w", err)})
+
+				// retried on the next sync
+				newInventory = append(newInventory, obj)
+
+				continue
+			}
+
+			changes = append(changes, Change{Object: obj, Action: Pruned})
+		}
+	} else {
+		// the objects are pruned once the pruning is enabled again
+		newInventory = append(newInventory, remove...)
+	}
+
+	if err := saveInventory(ctx, s.clientset, dedupe(newInventory)); err != nil {
+		return changes, err
+	}
+
+	return changes, nil
+}
+
+// apply the object returning the action if the object was changed.
+func (s *Syncer) apply(ctx context.Context, manifest string, obj *unstructured.Unstructured) (Object, *Action, error) {
+	mapping, err := s.restMapping(obj.GroupVersionKind())
+	if err != nil {
+		return newObject(manifest, obj), nil, err
+	}
+
+	resource := s.dynamic.Resource(mapping.Resource)
+
+	var client dynamic.ResourceInterface = resource
+
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(metav1.NamespaceDefault)
+		}
+
+		client = resource.Namespace(obj.GetNamespace())
+	}
+
+	ref := newObject(manifest, obj)
+
+	existing, err := client.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ref, nil, err
+		}
+
+		existing = nil
+	}
+
+	data, err := obj.MarshalJSON()
+	if err != nil {
+		return ref, nil, err
+	}
+
+	force := true
+
+	result, err := client.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
+		FieldManager: fieldManager,
+		Force:        &force,
+	})
+	if err != nil {
+		return ref, nil, err
+	}
+
+	var action Action
+
+	switch {
+	case existing == nil:
+		action = Created
+	case existing.GetResourceVersion() != result.GetResourceVersion():
+		action = Updated
+	default:
+		return ref, nil, nil
+	}
+
+	return ref, &action, nil
+}
+
+func (s *Syncer) delete(ctx context.Context, obj Object) error {
+	gvk := schema.FromAPIVersionAndKind(obj.APIVersion, obj.Kind)
+
+	mapping, err := s.restMapping(gvk)
+	if err != nil {
+		// the CRD of the object was removed along with the object
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	var client dynamic.ResourceInterface = s.dynamic.Resource(mapping.Resource)
+
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		client = s.dynamic.Resource(mapping.Resource).Namespace(obj.Namespace)
+	}
+
+	propagation := metav1.DeletePropagationBackground
+
+	err = client.Delete(ctx, obj.Name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// restMapping resolves the resource of the kind, the discovery is refreshed
+// once if the kind is unknown, e.g. the CRD was just applied.
+func (s *Syncer) restMapping(gvk schema.GroupVersionKind) (*meta.RESTMapping, error) {
+	mapping, err := s.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil && meta.IsNoMatchError(err) {
+		s.mapper.Reset()
+
+		mapping, err = s.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	}
+
+	return mapping, err
+}
+
+func dedupe(objects []Object) []Object {
+	seen := make(map[string]struct{}, len(objects))
+	result := make([]Object, 0, len(objects))
+
+	for _, obj := range objects {
+		if _, ok := seen[obj.key()]; ok {
+			continue
+		}
+
+		seen[obj.key()] = struct{}{}
+
+		result = append(result, obj)
+	}
+
+	return result
+}
+
+// Error aggregates the errors of the changes.
+func Error(changes []Change) error {
+	var result *multierror.Error
+
+	for _, change := range changes {
+		if change.Err != nil {
+			result = multierror.Append(result, fmt.Errorf("%s: %w", change.Object.Manifest, change.Err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
@@ -0,0 +1,347 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package manifests //nolint: testpackage
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+const (
+	ciManifest = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: ci
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+  namespace: ci
+data:
+  key: value
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: runners
+  namespace: ci
+data:
+  count: "3"
+`
+
+	ciNamespaceManifest = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: ci
+`
+
+	monitoringManifest = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: monitoring
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: prometheus
+  namespace: monitoring
+`
+
+	// the kind is unknown until the CRD is applied
+	monitoringCRManifest = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: monitoring
+---
+apiVersion: monitoring.coreos.com/v1
+kind: Prometheus
+metadata:
+  name: prometheus
+  namespace: monitoring
+`
+)
+
+var (
+	namespaces = schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	configMaps = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+)
+
+type resetMapper struct {
+	meta.RESTMapper
+}
+
+func (resetMapper) Reset() {}
+
+// applyReactor emulates the server-side apply of the whole object, as the
+// fake dynamic client doesn't support the apply patches.
+func applyReactor(tracker k8stesting.ObjectTracker) k8stesting.ReactionFunc {
+	return func(action k8stesting.Action) (bool, runtime.Object, error) {
+		patch, ok := action.(k8stesting.PatchAction)
+		if !ok || patch.GetPatchType() != types.ApplyPatchType {
+			return false, nil, nil
+		}
+
+		obj := &unstructured.Unstructured{}
+
+		if err := obj.UnmarshalJSON(patch.GetPatch()); err != nil {
+			return true, nil, err
+		}
+
+		existing, err := tracker.Get(patch.GetResource(), patch.GetNamespace(), patch.GetName())
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return true, nil, err
+			}
+
+			obj.SetResourceVersion("1")
+
+			return true, obj, tracker.Create(patch.GetResource(), obj, patch.GetNamespace())
+		}
+
+		current := existing.(*unstructured.Unstructured) //nolint: errcheck
+		version := current.GetResourceVersion()
+
+		if reflect.DeepEqual(normalize(current), normalize(obj)) {
+			current.SetResourceVersion(version)
+
+			return true, current, nil
+		}
+
+		n, _ := strconv.Atoi(version) //nolint: errcheck
+
+		obj.SetResourceVersion(strconv.Itoa(n + 1))
+
+		return true, obj, tracker.Update(patch.GetResource(), obj, patch.GetNamespace())
+	}
+}
+
+// normalize drops the fields set by the tracker for the comparison.
+func normalize(obj *unstructured.Unstructured) map[string]interface{} {
+	obj = obj.DeepCopy()
+
+	unstructured.RemoveNestedField(obj.Object, "metadata", "resourceVersion")
+
+	if obj.GetNamespace() == "" {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "namespace")
+	}
+
+	return obj.Object
+}
+
+func newTestSyncer() (*Syncer, *dynamicfake.FakeDynamicClient, k8stesting.ObjectTracker) {
+	scheme := runtime.NewScheme()
+	tracker := k8stesting.NewObjectTracker(scheme, serializer.NewCodecFactory(scheme).UniversalDecoder())
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme)
+	dynamicClient.PrependReactor("*", "*", k8stesting.ObjectReaction(tracker))
+	dynamicClient.PrependReactor("patch", "*", applyReactor(tracker))
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}, meta.RESTScopeRoot)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+
+	return &Syncer{
+		clientset: fake.NewSimpleClientset(),
+		dynamic:   dynamicClient,
+		mapper:    resetMapper{mapper},
+	}, dynamicClient, tracker
+}
+
+func testManifests(contents ...string) []config.Manifest {
+	result := make([]config.Manifest, 0, len(contents)/2)
+
+	for i := 0; i < len(contents); i += 2 {
+		result = append(result, &v1alpha1.ManifestConfig{
+			ManifestName:     contents[i],
+			ManifestContents: contents[i+1],
+		})
+	}
+
+	return result
+}
+
+var actions = map[Action]string{
+	Created: "created",
+	Updated: "updated",
+	Pruned:  "pruned",
+	Failed:  "failed",
+}
+
+func summary(changes []Change) []string {
+	result := make([]string, 0, len(changes))
+
+	for _, change := range changes {
+		result = append(result, actions[change.Action]+" "+change.Object.String())
+	}
+
+	return result
+}
+
+func deleted(client *dynamicfake.FakeDynamicClient) []string {
+	var result []string
+
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "delete" {
+			result = append(result, action.(k8stesting.DeleteAction).GetName())
+		}
+	}
+
+	return result
+}
+
+func TestSyncApply(t *testing.T) {
+	ctx := context.Background()
+	syncer, client, tracker := newTestSyncer()
+
+	changes, err := syncer.Sync(ctx, testManifests("ci", ciManifest), true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"created Namespace/ci",
+		"created ConfigMap/ci/settings",
+		"created ConfigMap/ci/runners",
+	}, summary(changes))
+
+	_, err = tracker.Get(configMaps, "ci", "settings")
+	require.NoError(t, err)
+
+	// nothing has changed
+	changes, err = syncer.Sync(ctx, testManifests("ci", ciManifest), true)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	// the object drifted from the manifest
+	obj, err := tracker.Get(configMaps, "ci", "settings")
+	require.NoError(t, err)
+
+	settings := obj.(*unstructured.Unstructured) //nolint: errcheck
+	require.NoError(t, unstructured.SetNestedField(settings.Object, "changed", "data", "key"))
+	settings.SetResourceVersion("2")
+	require.NoError(t, tracker.Update(configMaps, settings, "ci"))
+
+	changes, err = syncer.Sync(ctx, testManifests("ci", ciManifest), true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"updated ConfigMap/ci/settings"}, summary(changes))
+
+	obj, err = tracker.Get(configMaps, "ci", "settings")
+	require.NoError(t, err)
+
+	value, _, err := unstructured.NestedString(obj.(*unstructured.Unstructured).Object, "data", "key")
+	require.NoError(t, err)
+	assert.Equal(t, "value", value)
+
+	assert.Empty(t, deleted(client))
+}
+
+func TestSyncPrune(t *testing.T) {
+	ctx := context.Background()
+	syncer, client, tracker := newTestSyncer()
+
+	_, err := syncer.Sync(ctx, testManifests("ci", ciManifest, "monitoring", monitoringManifest), true)
+	require.NoError(t, err)
+
+	// the objects are kept while the pruning is disabled
+	changes, err := syncer.Sync(ctx, testManifests("ci", ciNamespaceManifest, "monitoring", monitoringManifest), false)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+	assert.Empty(t, deleted(client))
+
+	_, err = tracker.Get(configMaps, "ci", "runners")
+	require.NoError(t, err)
+
+	inventory, err := loadInventory(ctx, syncer.clientset)
+	require.NoError(t, err)
+	assert.Len(t, inventory, 5)
+
+	// the objects are pruned in the reverse order once the pruning is enabled
+	changes, err = syncer.Sync(ctx, testManifests("ci", ciNamespaceManifest, "monitoring", monitoringManifest), true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"pruned ConfigMap/ci/runners",
+		"pruned ConfigMap/ci/settings",
+	}, summary(changes))
+	assert.Equal(t, []string{"runners", "settings"}, deleted(client))
+
+	_, err = tracker.Get(configMaps, "ci", "runners")
+	assert.True(t, apierrors.IsNotFound(err))
+
+	// the whole manifest is removed
+	changes, err = syncer.Sync(ctx, testManifests("ci", ciNamespaceManifest), true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"pruned ConfigMap/monitoring/prometheus",
+		"pruned Namespace/monitoring",
+	}, summary(changes))
+
+	_, err = tracker.Get(namespaces, "", "monitoring")
+	assert.True(t, apierrors.IsNotFound(err))
+
+	inventory, err = loadInventory(ctx, syncer.clientset)
+	require.NoError(t, err)
+	assert.Equal(t, []Object{
+		{Manifest: "ci", APIVersion: "v1", Kind: "Namespace", Name: "ci"},
+	}, inventory)
+}
+
+func TestSyncFailed(t *testing.T) {
+	ctx := context.Background()
+	syncer, client, tracker := newTestSyncer()
+
+	_, err := syncer.Sync(ctx, testManifests("ci", ciManifest, "monitoring", monitoringManifest), true)
+	require.NoError(t, err)
+
+	// the manifest fails to apply, so its objects are not pruned
+	changes, err := syncer.Sync(ctx, testManifests("ci", ciManifest, "monitoring", monitoringCRManifest), true)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, Failed, changes[0].Action)
+	assert.Equal(t, "Prometheus/monitoring/prometheus", changes[0].Object.String())
+	assert.Error(t, Error(changes))
+
+	// the manifest fails to load
+	changes, err = syncer.Sync(ctx, testManifests("ci", ciManifest, "monitoring", "kind: Namespace"), true)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, Failed, changes[0].Action)
+	assert.Equal(t, "monitoring", changes[0].Object.Manifest)
+
+	assert.Empty(t, deleted(client))
+
+	_, err = tracker.Get(configMaps, "monitoring", "prometheus")
+	require.NoError(t, err)
+
+	inventory, err := loadInventory(ctx, syncer.clientset)
+	require.NoError(t, err)
+	assert.Contains(t, inventory, Object{Manifest: "monitoring", APIVersion: "v1", Kind: "ConfigMap", Namespace: "monitoring", Name: "prometheus"})
+
+	// the objects are pruned once the manifest is removed, the failed object
+	// is pruned as well, as its kind is unknown
+	changes, err = syncer.Sync(ctx, testManifests("ci", ciManifest), true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"pruned ConfigMap/monitoring/prometheus",
+		"pruned Prometheus/monitoring/prometheus",
+		"pruned Namespace/monitoring",
+	}, summary(changes))
+	assert.Equal(t, []string{"prometheus", "monitoring"}, deleted(client))
+}
@@ -0,0 +1,306 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package meta implements the key/value store kept on the META partition.
+//
+// The store survives the reinstall of the STATE partition, so that the
+// provisioning tools can keep the per-node data there. The store occupies the
+// beginning of the partition, the end of it holds the bootloader ADV.
+package meta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/talos-systems/go-blockdevice/blockdevice/probe"
+
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+const (
+	// Size is the size of a single copy of the store, the store is written
+	// twice so that it survives an interrupted write.
+	Size = 256 * 1024
+
+	// MaxKeyLength is the maximum length of a key.
+	MaxKeyLength = 256
+
+	magic      = uint32(0x564b4d54)
+	headerSize = 12
+)
+
+// Keys of the values recorded by Talos itself.
+const (
+	// ReservedPrefix is the prefix of the keys reserved for Talos.
+	ReservedPrefix = "talos/"
+
+	// KernelArgsKey holds the kernel args set via the API.
+	KernelArgsKey = "talos/kernel-args"
+	// KernelArgsRemoveKey holds the kernel args removed via the API.
+	KernelArgsRemoveKey = "talos/kernel-args-remove"
+)
+
+var (
+	// ErrCorrupted is returned when none of the copies of the store pass the
+	// checksum verification.
+	ErrCorrupted = errors.New("META key/value store is corrupted")
+	// ErrNoSpace is returned when the values don't fit into the store.
+	ErrNoSpace = errors.New("no space left in META key/value store")
+)
+
+// Values is the content of the store.
+type Values map[string]string
+
+// Validate checks the keys and the total size of the values.
+func (v Values) Validate() error {
+	for key := range v {
+		if key == "" {
+			return fmt.Errorf("key can't be empty")
+		}
+
+		if len(key) > MaxKeyLength {
+			return fmt.Errorf("key %q is longer than %d bytes", key, MaxKeyLength)
+		}
+	}
+
+	if len(v.marshal()) > Size-headerSize {
+		return ErrNoSpace
+	}
+
+	return nil
+}
+
+// IsReserved checks if the key is reserved for Talos, such keys can't be
+// changed via the API.
+func IsReserved(key string) bool {
+	return strings.HasPrefix(key, ReservedPrefix)
+}
+
+// Keys returns the sorted keys.
+func (v Values) Keys() []string {
+	keys := make([]string, 0, len(v))
+
+	for key := range v {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// KernelArgs returns the kernel args set and removed via the API.
+func (v Values) KernelArgs() (set, remove []string) {
+	return strings.Fields(v[KernelArgsKey]), strings.Fields(v[KernelArgsRemoveKey])
+}
+
+// SetKernelArgs records the kernel args set and removed via the API, so that
+// they are carried over by the upgrades.
+func (v Values) SetKernelArgs(set, remove []string) {
+	delete(v, KernelArgsKey)
+	delete(v, KernelArgsRemoveKey)
+
+	if len(set) > 0 {
+		v[KernelArgsKey] = strings.Join(set, " ")
+	}
+
+	if len(remove) > 0 {
+		v[KernelArgsRemoveKey] = strings.Join(remove, " ")
+	}
+}
+
+// marshal encodes the values as the sequence of the length-prefixed keys and
+// values.
+func (v Values) marshal() []byte {
+	var buf bytes.Buffer
+
+	n := make([]byte, 4)
+
+	for _, key := range v.Keys() {
+		binary.LittleEndian.PutUint16(n, uint16(len(key)))
+		buf.Write(n[:2])
+		buf.WriteString(key)
+
+		binary.LittleEndian.PutUint32(n, uint32(len(v[key])))
+		buf.Write(n)
+		buf.WriteString(v[key])
+	}
+
+	return buf.Bytes()
+}
+
+func unmarshal(b []byte) (Values, error) {
+	v := Values{}
+
+	for len(b) > 0 {
+		if len(b) < 2 {
+			return nil, ErrCorrupted
+		}
+
+		keyLen := int(binary.LittleEndian.Uint16(b))
+		b = b[2:]
+
+		if len(b) < keyLen+4 {
+			return nil, ErrCorrupted
+		}
+
+		key := string(b[:keyLen])
+		valLen := int(binary.LittleEndian.Uint32(b[keyLen:]))
+		b = b[keyLen+4:]
+
+		if len(b) < valLen {
+			return nil, ErrCorrupted
+		}
+
+		v[key] = string(b[:valLen])
+		b = b[valLen:]
+	}
+
+	return v, nil
+}
+
+// Read reads the store. The backup copy is used if the primary one is
+// corrupted, and the store is empty if it was never written.
+func Read(r io.ReaderAt) (Values, error) {
+	empty := true
+
+	for _, offset := range []int64{0, Size} {
+		v, err := readCopy(r, offset)
+
+		switch {
+		case err == nil && v == nil:
+			continue
+		case err == nil:
+			return v, nil
+		case errors.Is(err, ErrCorrupted):
+			empty = false
+		default:
+			return nil, err
+		}
+	}
+
+	if empty {
+		return Values{}, nil
+	}
+
+	return nil, ErrCorrupted
+}
+
+// readCopy returns nil values if there is no store at the offset.
+func readCopy(r io.ReaderAt, offset int64) (Values, error) {
+	header := make([]byte, headerSize)
+
+	if _, err := r.ReadAt(header, offset); err != nil {
+		return nil, fmt.Errorf("failed to read META key/value store: %w", err)
+	}
+
+	if binary.LittleEndian.Uint32(header) != magic {
+		return nil, nil
+	}
+
+	length := binary.LittleEndian.Uint32(header[4:])
+	if length > Size-headerSize {
+		return nil, ErrCorrupted
+	}
+
+	data := make([]byte, length)
+
+	if _, err := r.ReadAt(data, offset+headerSize); err != nil {
+		return nil, fmt.Errorf("failed to read META key/value store: %w", err)
+	}
+
+	if crc32.ChecksumIEEE(data) != binary.LittleEndian.Uint32(header[8:]) {
+		return nil, ErrCorrupted
+	}
+
+	return unmarshal(data)
+}
+
+// Write writes both copies of the store. The primary copy is written first,
+// so that the backup copy holds the previous values until the primary one is
+// complete.
+func Write(w io.WriterAt, v Values) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+
+	data := v.marshal()
+
+	b := make([]byte, headerSize+len(data))
+
+	binary.LittleEndian.PutUint32(b, magic)
+	binary.LittleEndian.PutUint32(b[4:], uint32(len(data)))
+	binary.LittleEndian.PutUint32(b[8:], crc32.ChecksumIEEE(data))
+	copy(b[headerSize:], data)
+
+	for _, offset := range []int64{0, Size} {
+		if _, err := w.WriteAt(b, offset); err != nil {
+			return fmt.Errorf("failed to write META key/value store: %w", err)
+		}
+
+		if f, ok := w.(*os.File); ok {
+			if err := f.Sync(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// mu serializes the updates of the store on the META partition.
+var mu sync.Mutex
+
+// Load reads the store from the META partition.
+func Load() (Values, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	f, err := probe.GetPartitionWithName(constants.MetaPartitionLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer f.Close()
+
+	return Read(f)
+}
+
+// Update applies the changes to the store on the META partition.
+func Update(update func(Values) error) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	f, err := probe.GetPartitionWithName(constants.MetaPartitionLabel)
+	if err != nil {
+		return err
+	}
+
+	// nolint: errcheck
+	defer f.Close()
+
+	v, err := Read(f)
+	if err != nil {
+		return err
+	}
+
+	if err = update(v); err != nil {
+		return err
+	}
+
+	if err = Write(f, v); err != nil {
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,115 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package meta_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/meta"
+)
+
+func newPartition(t *testing.T) *os.File {
+	f, err := ioutil.TempFile("", "meta")
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		f.Close()           //nolint: errcheck
+		os.Remove(f.Name()) //nolint: errcheck
+	})
+
+	require.NoError(t, f.Truncate(1024*1024))
+
+	return f
+}
+
+func TestReadWrite(t *testing.T) {
+	f := newPartition(t)
+
+	v, err := meta.Read(f)
+	require.NoError(t, err)
+	assert.Empty(t, v)
+
+	v["rack"] = "r42"
+	v["network"] = "interfaces:\n  - interface: eth0\n    dhcp: true\n"
+
+	require.NoError(t, meta.Write(f, v))
+
+	loaded, err := meta.Read(f)
+	require.NoError(t, err)
+	assert.Equal(t, v, loaded)
+	assert.Equal(t, []string{"network", "rack"}, loaded.Keys())
+
+	delete(v, "rack")
+
+	require.NoError(t, meta.Write(f, v))
+
+	loaded, err = meta.Read(f)
+	require.NoError(t, err)
+	assert.Equal(t, meta.Values{"network": v["network"]}, loaded)
+}
+
+func TestCorrupted(t *testing.T) {
+	f := newPartition(t)
+
+	require.NoError(t, meta.Write(f, meta.Values{"rack": "r42"}))
+
+	// corrupt the primary copy, the backup is used
+	_, err := f.WriteAt([]byte("r43"), 12+2+4+4)
+	require.NoError(t, err)
+
+	v, err := meta.Read(f)
+	require.NoError(t, err)
+	assert.Equal(t, meta.Values{"rack": "r42"}, v)
+
+	_, err = f.WriteAt([]byte("r43"), meta.Size+12+2+4+4)
+	require.NoError(t, err)
+
+	_, err = meta.Read(f)
+	assert.True(t, errors.Is(err, meta.ErrCorrupted))
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, meta.Values{"rack": "r42"}.Validate())
+	assert.Error(t, meta.Values{"": "r42"}.Validate())
+	assert.Error(t, meta.Values{strings.Repeat("k", meta.MaxKeyLength+1): "r42"}.Validate())
+	assert.True(t, errors.Is(meta.Values{"rack": strings.Repeat("r", meta.Size)}.Validate(), meta.ErrNoSpace))
+}
+
+func TestIsReserved(t *testing.T) {
+	assert.True(t, meta.IsReserved(meta.KernelArgsKey))
+	assert.True(t, meta.IsReserved(meta.KernelArgsRemoveKey))
+	assert.False(t, meta.IsReserved("rack"))
+	assert.False(t, meta.IsReserved("mytalos/rack"))
+}
+
+func TestKernelArgs(t *testing.T) {
+	v := meta.Values{"rack": "r42"}
+
+	set, remove := v.KernelArgs()
+	assert.Empty(t, set)
+	assert.Empty(t, remove)
+
+	v.SetKernelArgs([]string{"console=ttyS0", "net.ifnames=0"}, []string{"quiet"})
+
+	set, remove = v.KernelArgs()
+	assert.Equal(t, []string{"console=ttyS0", "net.ifnames=0"}, set)
+	assert.Equal(t, []string{"quiet"}, remove)
+
+	v.SetKernelArgs([]string{"console=ttyS1"}, nil)
+
+	assert.Equal(t, meta.Values{"rack": "r42", meta.KernelArgsKey: "console=ttyS1"}, v)
+
+	// the updates are not bound by the bootloader ADV size
+	v.SetKernelArgs([]string{strings.Repeat("a", 4096)}, nil)
+
+	assert.NoError(t, v.Validate())
+}
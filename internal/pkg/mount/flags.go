@@ -0,0 +1,44 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mount
+
+import (
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+// flags maps the mount options to the mount flags.
+var flags = map[string]uintptr{
+	"ro":          unix.MS_RDONLY,
+	"nosuid":      unix.MS_NOSUID,
+	"nodev":       unix.MS_NODEV,
+	"noexec":      unix.MS_NOEXEC,
+	"sync":        unix.MS_SYNCHRONOUS,
+	"dirsync":     unix.MS_DIRSYNC,
+	"noatime":     unix.MS_NOATIME,
+	"nodiratime":  unix.MS_NODIRATIME,
+	"relatime":    unix.MS_RELATIME,
+	"strictatime": unix.MS_STRICTATIME,
+	"lazytime":    unix.MS_LAZYTIME,
+}
+
+// ParseOptions converts the mount options as accepted by mount(8) into the
+// mount flags and the filesystem specific data.
+func ParseOptions(options []string) (flag uintptr, data string) {
+	rest := []string{}
+
+	for _, option := range options {
+		if f, ok := flags[option]; ok {
+			flag |= f
+
+			continue
+		}
+
+		rest = append(rest, option)
+	}
+
+	return flag, strings.Join(rest, ",")
+}
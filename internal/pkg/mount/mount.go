@@ -6,10 +6,13 @@ package mount
 
 import (
 	"bufio"
+	"context"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,13 +20,16 @@ import (
 	"golang.org/x/sys/unix"
 
 	"github.com/talos-systems/go-blockdevice/blockdevice"
-	gptpartition "github.com/talos-systems/go-blockdevice/blockdevice/table/gpt/partition"
-	"github.com/talos-systems/go-blockdevice/blockdevice/util"
 
+	"github.com/talos-systems/talos/internal/pkg/encryption"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/makefs"
 )
 
+// encryptionTimeout bounds unlocking and locking of an encrypted partition,
+// so that an unreachable key service doesn't block the boot forever.
+const encryptionTimeout = 5 * time.Minute
+
 // RetryFunc defines the requirements for retrying a mount point operation.
 type RetryFunc func(*Point) error
 
@@ -56,6 +62,18 @@ func mountMountpoint(mountpoint *Point) (err error) {
 		}
 	}
 
+	// Unlock the partition, the filesystem is mounted from the unlocked device.
+	if mountpoint.encryption != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), encryptionTimeout)
+		mountpoint.source, err = mountpoint.encryption.Open(ctx)
+
+		cancel()
+
+		if err != nil {
+			return fmt.Errorf("error unlocking: %w", err)
+		}
+	}
+
 	if mountpoint.SkipIfMounted {
 		skipMount, err = mountpoint.IsMounted()
 		if err != nil {
@@ -87,6 +105,17 @@ func Unmount(mountpoints *Points) (err error) {
 		if err = mountpoint.Unmount(); err != nil {
 			return fmt.Errorf("unmount: %w", err)
 		}
+
+		if mountpoint.encryption != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), encryptionTimeout)
+			err = mountpoint.encryption.Close(ctx)
+
+			cancel()
+
+			if err != nil {
+				return fmt.Errorf("lock: %w", err)
+			}
+		}
 	}
 
 	if iter.Err() != nil {
@@ -152,6 +181,8 @@ type Point struct {
 	flags  uintptr
 	data   string
 	*Options
+
+	encryption *encryption.Handler
 }
 
 // PointMap represents a unique set of mount points.
@@ -294,9 +325,8 @@ func (p *Point) Move(prefix string) (err error) {
 
 // ResizePartition resizes a partition to the maximum size allowed.
 func (p *Point) ResizePartition() (err error) {
-	var devname string
-
-	if devname, err = util.DevnameFromPartname(p.Source()); err != nil {
+	devname, partno, err := partitionOf(p.Source())
+	if err != nil {
 		return err
 	}
 
@@ -318,7 +348,7 @@ func (p *Point) ResizePartition() (err error) {
 	}
 
 	for _, partition := range pt.Partitions() {
-		if partition.(*gptpartition.Partition).Name == constants.EphemeralPartitionLabel {
+		if partition.No() == partno {
 			if err := pt.Resize(partition); err != nil {
 				return err
 			}
@@ -335,13 +365,48 @@ func (p *Point) ResizePartition() (err error) {
 // GrowFilesystem grows a partition's filesystem to the maximum size allowed.
 // NB: An XFS partition MUST be mounted, or this will fail.
 func (p *Point) GrowFilesystem() (err error) {
-	if err = makefs.XFSGrow(p.Target()); err != nil {
-		return fmt.Errorf("xfs_growfs: %w", err)
+	switch p.fstype {
+	case "ext4":
+		if err = makefs.Ext4Resize(p.Source()); err != nil {
+			return fmt.Errorf("resize2fs: %w", err)
+		}
+	default:
+		if err = makefs.XFSGrow(p.Target()); err != nil {
+			return fmt.Errorf("xfs_growfs: %w", err)
+		}
 	}
 
 	return nil
 }
 
+// partitionOf returns the name of the block device and the number of the
+// partition as reported by sysfs.
+func partitionOf(partname string) (devname string, partno int32, err error) {
+	if partname, err = filepath.EvalSymlinks(partname); err != nil {
+		return "", 0, err
+	}
+
+	sysfs := filepath.Join("/sys/class/block", filepath.Base(partname))
+
+	b, err := ioutil.ReadFile(filepath.Join(sysfs, "partition"))
+	if err != nil {
+		return "", 0, fmt.Errorf("%s is not a partition: %w", partname, err)
+	}
+
+	n, err := strconv.ParseInt(strings.TrimSpace(string(b)), 10, 32)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to parse partition number of %s: %w", partname, err)
+	}
+
+	// /sys/class/block/sda1 -> ../../devices/pci0000:00/0000:00:1f.2/ata1/host0/target0:0:0/0:0:0:0/block/sda/sda1
+	link, err := filepath.EvalSymlinks(sysfs)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return filepath.Base(filepath.Dir(link)), int32(n), nil
+}
+
 func mount(p *Point) (err error) {
 	return unix.Mount(p.source, p.target, p.fstype, p.flags, p.data)
 }
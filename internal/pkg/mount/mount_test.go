@@ -4,11 +4,44 @@
 
 package mount_test
 
-import "testing"
+import (
+	"testing"
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/internal/pkg/mount"
+)
+
+func TestParseOptions(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		options []string
+		flags   uintptr
+		data    string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:    "flags",
+			options: []string{"noatime", "nodev", "ro"},
+			flags:   unix.MS_NOATIME | unix.MS_NODEV | unix.MS_RDONLY,
+		},
+		{
+			name:    "data",
+			options: []string{"noatime", "discard", "commit=30"},
+			flags:   unix.MS_NOATIME,
+			data:    "discard,commit=30",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			flags, data := mount.ParseOptions(tt.options)
+
+			assert.Equal(t, tt.flags, flags)
+			assert.Equal(t, tt.data, data)
+		})
+	}
 }
@@ -4,6 +4,11 @@
 
 package mount
 
+import (
+	"github.com/talos-systems/talos/internal/pkg/encryption"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
 // Options is the functional options struct.
 type Options struct {
 	Loopback      string
@@ -13,6 +18,8 @@ type Options struct {
 	Resize        bool
 	Overlay       bool
 	SkipIfMounted bool
+	Encryption    config.Encryption
+	NodeSecret    encryption.NodeSecretFunc
 }
 
 // Option is the functional option func.
@@ -62,6 +69,22 @@ func WithOverlay(o bool) Option {
 	}
 }
 
+// WithEncryption is a functional option for setting the encryption config
+// of a system partition.
+func WithEncryption(o config.Encryption) Option {
+	return func(args *Options) {
+		args.Encryption = o
+	}
+}
+
+// WithNodeSecret is a functional option for setting the source of the node
+// secret the nodeID encryption keys are derived from.
+func WithNodeSecret(o encryption.NodeSecretFunc) Option {
+	return func(args *Options) {
+		args.NodeSecret = o
+	}
+}
+
 // NewDefaultOptions initializes a Options struct with default values.
 func NewDefaultOptions(setters ...Option) *Options {
 	opts := &Options{
@@ -5,13 +5,17 @@
 package mount
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"os"
 
 	"golang.org/x/sys/unix"
 
+	"github.com/talos-systems/go-blockdevice/blockdevice/filesystem"
 	"github.com/talos-systems/go-blockdevice/blockdevice/probe"
 
+	"github.com/talos-systems/talos/internal/pkg/encryption"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
@@ -19,11 +23,16 @@ import (
 // This function is called exclusively during installations ( both image
 // creation and bare metall installs ). This is why we want to look up
 // device by specified disk as well as why we don't want to grow any
-// filesystems.
-func SystemMountPointsForDevice(devpath string) (mountpoints *Points, err error) {
+// filesystems. The partitions listed in skip (e.g. the encrypted ones, which
+// have no filesystem yet) are not mounted.
+func SystemMountPointsForDevice(devpath string, skip ...string) (mountpoints *Points, err error) {
 	mountpoints = NewMountPoints()
 
 	for _, name := range []string{constants.EphemeralPartitionLabel, constants.BootPartitionLabel, constants.EFIPartitionLabel, constants.StatePartitionLabel} {
+		if contains(skip, name) {
+			continue
+		}
+
 		var target string
 
 		switch name {
@@ -78,6 +87,12 @@ func SystemMountPointForLabel(label string, opts ...Option) (mountpoint *Point,
 		return nil, fmt.Errorf("unknown label: %q", label)
 	}
 
+	if label == constants.StatePartitionLabel || label == constants.EphemeralPartitionLabel {
+		if mountpoint, err = encryptedMountPointForLabel(label, target, opts...); err != nil || mountpoint != nil {
+			return mountpoint, err
+		}
+	}
+
 	var dev *probe.ProbedBlockDevice
 
 	if dev, err = probe.GetDevWithFileSystemLabel(label); err != nil {
@@ -96,3 +111,70 @@ func SystemMountPointForLabel(label string, opts ...Option) (mountpoint *Point,
 
 	return mountpoint, nil
 }
+
+// encryptedMountPointForLabel returns a mount point for the partition if it
+// is encrypted, or should be encrypted according to the config. The
+// filesystem label of an encrypted partition is not visible until it is
+// unlocked, so the partition is looked up by its name.
+func encryptedMountPointForLabel(label, target string, opts ...Option) (*Point, error) {
+	f, err := probe.GetPartitionWithName(label)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to find partition with name %s: %w", label, err)
+	}
+
+	partition := f.Name()
+
+	if err = f.Close(); err != nil {
+		return nil, err
+	}
+
+	options := NewDefaultOptions(opts...)
+
+	encrypted, err := encryption.IsLUKS(partition)
+	if err != nil {
+		return nil, err
+	}
+
+	if !encrypted {
+		if options.Encryption == nil {
+			return nil, nil
+		}
+
+		var sb filesystem.SuperBlocker
+
+		if sb, err = probe.FileSystem(partition); err != nil {
+			return nil, err
+		}
+
+		// Never format a partition which already has a filesystem.
+		if sb != nil {
+			log.Printf("WARNING: encryption is configured for %s partition, but it is not encrypted, reinstall to encrypt it", label)
+
+			return nil, nil
+		}
+	}
+
+	handler, err := encryption.NewHandler(partition, label, options.Encryption, options.NodeSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	mountpoint := NewMountPoint(partition, target, "xfs", unix.MS_NOATIME, "", opts...)
+	mountpoint.encryption = handler
+
+	return mountpoint, nil
+}
+
+func contains(labels []string, label string) bool {
+	for _, l := range labels {
+		if l == label {
+			return true
+		}
+	}
+
+	return false
+}
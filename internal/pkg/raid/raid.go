@@ -0,0 +1,121 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package raid assembles the software RAID arrays of the user disks.
+package raid
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/talos-systems/go-blockdevice/blockdevice/util"
+
+	"github.com/talos-systems/talos/pkg/cmd"
+)
+
+// Assemble starts the array from the devices. It returns false if none of the
+// devices belongs to an array yet, so that the array should be created.
+func Assemble(array string, devices []string) (assembled bool, err error) {
+	if active(array) {
+		return true, nil
+	}
+
+	for _, dev := range devices {
+		// mdadm fails to examine the device which has no RAID superblock
+		if _, err = cmd.Run("mdadm", "--examine", dev); err != nil {
+			continue
+		}
+
+		if _, err = cmd.Run("mdadm", append([]string{"--assemble", array}, devices...)...); err != nil {
+			return false, fmt.Errorf("failed to assemble RAID array %q: %w", array, err)
+		}
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// Create creates the array from the devices, the data on the devices is lost.
+func Create(array, level string, devices []string) error {
+	if _, err := cmd.Run("mdadm", createArgs(array, level, devices)...); err != nil {
+		return fmt.Errorf("failed to create RAID array %q: %w", array, err)
+	}
+
+	return nil
+}
+
+// emptySize covers the partition tables, the filesystem superblocks and the
+// volume labels at the beginning of the device.
+const emptySize = 1024 * 1024
+
+// Empty checks that the beginning of the device is zeroed, so that creating
+// the array on top of it loses no data.
+func Empty(device string) (bool, error) {
+	f, err := os.Open(device)
+	if err != nil {
+		return false, err
+	}
+
+	// nolint: errcheck
+	defer f.Close()
+
+	buf := make([]byte, emptySize)
+
+	n, err := io.ReadFull(f, buf)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return false, fmt.Errorf("failed to read %q: %w", device, err)
+	}
+
+	for _, b := range buf[:n] {
+		if b != 0 {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// PartPath returns the path to the partition of the device, unlike
+// util.PartPath it supports the RAID arrays.
+func PartPath(device string, n int) (string, error) {
+	if strings.HasPrefix(device, "/dev/md") {
+		return fmt.Sprintf("%sp%d", device, n), nil
+	}
+
+	return util.PartPath(device, n)
+}
+
+func createArgs(array, level string, devices []string) []string {
+	args := []string{
+		"--create", array,
+		// don't ask for the confirmation
+		"--run",
+		"--metadata=1.2",
+		"--level=" + strings.TrimPrefix(level, "raid"),
+		"--raid-devices=" + strconv.Itoa(len(devices)),
+	}
+
+	return append(args, devices...)
+}
+
+func active(array string) bool {
+	state, err := ioutil.ReadFile(filepath.Join("/sys/block", filepath.Base(array), "md/array_state"))
+	if err != nil {
+		return false
+	}
+
+	switch strings.TrimSpace(string(state)) {
+	case "clear", "inactive":
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,56 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package raid //nolint: testpackage
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateArgs(t *testing.T) {
+	assert.Equal(t,
+		[]string{"--create", "/dev/md0", "--run", "--metadata=1.2", "--level=1", "--raid-devices=2", "/dev/sdb", "/dev/sdc"},
+		createArgs("/dev/md0", "raid1", []string{"/dev/sdb", "/dev/sdc"}),
+	)
+}
+
+func TestPartPath(t *testing.T) {
+	for device, expected := range map[string]string{
+		"/dev/md0":     "/dev/md0p1",
+		"/dev/sdb":     "/dev/sdb1",
+		"/dev/nvme0n1": "/dev/nvme0n1p1",
+	} {
+		partname, err := PartPath(device, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, partname)
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	f, err := ioutil.TempFile("", "raid")
+	require.NoError(t, err)
+
+	defer os.Remove(f.Name()) //nolint: errcheck
+
+	require.NoError(t, f.Truncate(4*emptySize))
+
+	empty, err := Empty(f.Name())
+	require.NoError(t, err)
+	assert.True(t, empty)
+
+	// ext4 superblock magic
+	_, err = f.WriteAt([]byte{0x53, 0xef}, 1080)
+	require.NoError(t, err)
+
+	empty, err = Empty(f.Name())
+	require.NoError(t, err)
+	assert.False(t, empty)
+
+	require.NoError(t, f.Close())
+}
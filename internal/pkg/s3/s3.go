@@ -0,0 +1,226 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package s3 implements the minimal client of the S3-compatible object
+// storage: the objects are uploaded, listed and deleted.
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Client is the S3 client with the path-style bucket addressing.
+type Client struct {
+	endpoint *url.URL
+	bucket   string
+	signer   *Signer
+
+	httpClient *http.Client
+}
+
+// Object is the object stored in the bucket.
+type Object struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// Option configures the Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used for the requests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// NewClient initializes the client of the bucket.
+func NewClient(endpoint, region, bucket, accessKeyID, secretAccessKey string, opts ...Option) (*Client, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing S3 endpoint: %w", err)
+	}
+
+	c := &Client{
+		endpoint: u,
+		bucket:   bucket,
+		signer: &Signer{
+			Region:          region,
+			AccessKeyID:     accessKeyID,
+			SecretAccessKey: secretAccessKey,
+		},
+		httpClient: http.DefaultClient,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+// PutObject uploads the object, the body is read twice: to calculate the
+// payload hash and to send it.
+func (c *Client) PutObject(ctx context.Context, key string, body io.ReadSeeker) error {
+	h := sha256.New()
+
+	size, err := io.Copy(h, body)
+	if err != nil {
+		return err
+	}
+
+	if _, err = body.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPut, key, nil, ioutil.NopCloser(body), hex.EncodeToString(h.Sum(nil)))
+	if err != nil {
+		return err
+	}
+
+	req.ContentLength = size
+
+	return c.do(req, nil)
+}
+
+// DeleteObject deletes the object.
+func (c *Client) DeleteObject(ctx context.Context, key string) error {
+	req, err := c.newRequest(ctx, http.MethodDelete, key, nil, nil, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, nil)
+}
+
+type listBucketResult struct {
+	Contents []struct {
+		Key          string
+		Size         int64
+		LastModified time.Time
+	}
+	IsTruncated           bool
+	NextContinuationToken string
+}
+
+// ListObjects lists the objects with the key prefix.
+func (c *Client) ListObjects(ctx context.Context, prefix string) ([]Object, error) {
+	var (
+		objects           []Object
+		continuationToken string
+	)
+
+	for {
+		query := url.Values{
+			"list-type": {"2"},
+			"prefix":    {prefix},
+		}
+
+		if continuationToken != "" {
+			query.Set("continuation-token", continuationToken)
+		}
+
+		req, err := c.newRequest(ctx, http.MethodGet, "", query, nil, emptyPayloadHash)
+		if err != nil {
+			return nil, err
+		}
+
+		var result listBucketResult
+
+		if err = c.do(req, &result); err != nil {
+			return nil, err
+		}
+
+		for _, content := range result.Contents {
+			objects = append(objects, Object{
+				Key:          content.Key,
+				Size:         content.Size,
+				LastModified: content.LastModified,
+			})
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return objects, nil
+		}
+
+		continuationToken = result.NextContinuationToken
+	}
+}
+
+func (c *Client) newRequest(ctx context.Context, method, key string, query url.Values, body io.ReadCloser, payloadHash string) (*http.Request, error) {
+	u := *c.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + c.bucket
+
+	if key != "" {
+		u.Path += "/" + key
+	}
+
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	c.signer.Sign(req, payloadHash, time.Now())
+
+	return req, nil
+}
+
+// Error is the error response of the S3 API.
+type Error struct {
+	StatusCode int    `xml:"-"`
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+}
+
+func (e *Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("S3 request failed: %s", http.StatusText(e.StatusCode))
+	}
+
+	return fmt.Sprintf("S3 request failed: %s: %s", e.Code, e.Message)
+}
+
+func (c *Client) do(req *http.Request, result interface{}) error {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	// nolint: errcheck
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		apiErr := &Error{StatusCode: resp.StatusCode}
+
+		// the error body might be empty, e.g. for HEAD requests
+		xml.NewDecoder(resp.Body).Decode(apiErr) //nolint: errcheck
+
+		return apiErr
+	}
+
+	if result == nil {
+		_, err = io.Copy(ioutil.Discard, resp.Body)
+
+		return err
+	}
+
+	if err = xml.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("error decoding S3 response: %w", err)
+	}
+
+	return nil
+}
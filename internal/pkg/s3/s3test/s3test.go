@@ -0,0 +1,180 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package s3test provides the in-memory S3 stand-in for the tests.
+package s3test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/talos-systems/talos/internal/pkg/s3"
+)
+
+// Server is the in-memory S3 server of a single bucket, it verifies the
+// request signatures.
+type Server struct {
+	*httptest.Server
+
+	Bucket string
+	Signer *s3.Signer
+
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+// NewServer starts the server of the bucket.
+func NewServer(bucket string, signer *s3.Signer) *Server {
+	s := &Server{
+		Bucket:  bucket,
+		Signer:  signer,
+		objects: map[string][]byte{},
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+
+	return s
+}
+
+// Objects returns the sorted keys of the stored objects.
+func (s *Server) Objects() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]string, 0, len(s.objects))
+
+	for key := range s.objects {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Object returns the contents of the object.
+func (s *Server) Object(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, ok := s.objects[key]
+
+	return data, ok
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	if !s.verify(r, body) {
+		writeError(w, http.StatusForbidden, "SignatureDoesNotMatch")
+
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/")
+
+	if path != s.Bucket && !strings.HasPrefix(path, s.Bucket+"/") {
+		writeError(w, http.StatusNotFound, "NoSuchBucket")
+
+		return
+	}
+
+	key := strings.TrimPrefix(strings.TrimPrefix(path, s.Bucket), "/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPut && key != "":
+		s.objects[key] = body
+	case r.Method == http.MethodDelete && key != "":
+		delete(s.objects, key)
+
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet && key == "" && r.URL.Query().Get("list-type") == "2":
+		s.list(w, r.URL.Query().Get("prefix"))
+	default:
+		writeError(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func (s *Server) list(w http.ResponseWriter, prefix string) {
+	type content struct {
+		Key          string
+		Size         int
+		LastModified time.Time
+	}
+
+	result := struct {
+		XMLName  xml.Name `xml:"ListBucketResult"`
+		Name     string
+		Prefix   string
+		Contents []content
+	}{
+		Name:   s.Bucket,
+		Prefix: prefix,
+	}
+
+	for key, data := range s.objects {
+		if strings.HasPrefix(key, prefix) {
+			result.Contents = append(result.Contents, content{Key: key, Size: len(data), LastModified: time.Now()})
+		}
+	}
+
+	sort.Slice(result.Contents, func(i, j int) bool { return result.Contents[i].Key < result.Contents[j].Key })
+
+	w.Header().Set("Content-Type", "application/xml")
+	xml.NewEncoder(w).Encode(result) //nolint: errcheck
+}
+
+// verify signs the copy of the request with the same date and compares the
+// signatures.
+func (s *Server) verify(r *http.Request, body []byte) bool {
+	payloadHash := r.Header.Get("X-Amz-Content-Sha256")
+
+	if sum := sha256.Sum256(body); payloadHash != hex.EncodeToString(sum[:]) {
+		return false
+	}
+
+	date, err := time.Parse("20060102T150405Z", r.Header.Get("X-Amz-Date"))
+	if err != nil {
+		return false
+	}
+
+	req, err := http.NewRequest(r.Method, "http://"+r.Host+r.RequestURI, nil)
+	if err != nil {
+		return false
+	}
+
+	s.Signer.Sign(req, payloadHash, date)
+
+	return req.Header.Get("Authorization") == r.Header.Get("Authorization")
+}
+
+func writeError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+
+	xml.NewEncoder(w).Encode(struct { //nolint: errcheck
+		XMLName xml.Name `xml:"Error"`
+		Code    string
+		Message string
+	}{
+		Code:    code,
+		Message: http.StatusText(status),
+	})
+}
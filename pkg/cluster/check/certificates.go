@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package check provides set of checks to verify cluster readiness.
+package check
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-multierror"
+
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+// CertificateExpiryThreshold is the time before the expiry when
+// CertificateExpiryAssertion starts to fail.
+const CertificateExpiryThreshold = 30 * 24 * time.Hour
+
+// CertificateExpiryAssertion checks whether the certificates Talos manages on
+// the nodes are not close to the expiry.
+//
+// The certificates which are renewed automatically are skipped. The client
+// certificates of the kubeconfig the operator uses are not known to the nodes,
+// they are listed with `talosctl certificates --kubeconfig`.
+func CertificateExpiryAssertion(ctx context.Context, cluster ClusterInfo) error {
+	cli, err := cluster.Client()
+	if err != nil {
+		return err
+	}
+
+	nodesCtx := client.WithNodes(ctx, cluster.Nodes()...)
+
+	resp, err := cli.CertificateList(nodesCtx)
+	if err != nil {
+		return err
+	}
+
+	// sort the messages so that errors returned are consistent
+	sort.Slice(resp.Messages, func(i, j int) bool {
+		return resp.Messages[i].Metadata.GetHostname() < resp.Messages[j].Metadata.GetHostname()
+	})
+
+	var multiErr *multierror.Error
+
+	now := time.Now()
+
+	for _, msg := range resp.Messages {
+		for _, crt := range msg.Certificates {
+			if err = checkCertificateExpiry(crt, now); err != nil {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("%s: %w", msg.Metadata.GetHostname(), err))
+			}
+		}
+	}
+
+	return multiErr.ErrorOrNil()
+}
+
+func checkCertificateExpiry(crt *machineapi.Certificate, now time.Time) error {
+	if crt.GetAutoRenewed() {
+		return nil
+	}
+
+	notAfter, err := ptypes.Timestamp(crt.GetNotAfter())
+	if err != nil {
+		return fmt.Errorf("certificate %q: %w", crt.GetName(), err)
+	}
+
+	if left := notAfter.Sub(now); left < CertificateExpiryThreshold {
+		if left < 0 {
+			return fmt.Errorf("certificate %q expired at %s", crt.GetName(), notAfter.Format(time.RFC3339))
+		}
+
+		return fmt.Errorf("certificate %q expires at %s", crt.GetName(), notAfter.Format(time.RFC3339))
+	}
+
+	return nil
+}
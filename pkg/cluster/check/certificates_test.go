@@ -0,0 +1,35 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package check //nolint: testpackage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+func TestCheckCertificateExpiry(t *testing.T) {
+	now := time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	certificate := func(notAfter time.Time, autoRenewed bool) *machineapi.Certificate {
+		ts, err := ptypes.TimestampProto(notAfter)
+		assert.NoError(t, err)
+
+		return &machineapi.Certificate{
+			Name:        "etcd peer",
+			NotAfter:    ts,
+			AutoRenewed: autoRenewed,
+		}
+	}
+
+	assert.NoError(t, checkCertificateExpiry(certificate(now.AddDate(1, 0, 0), false), now))
+	assert.NoError(t, checkCertificateExpiry(certificate(now.Add(time.Hour), true), now))
+	assert.EqualError(t, checkCertificateExpiry(certificate(now.AddDate(0, 0, 7), false), now), `certificate "etcd peer" expires at 2020-09-08T00:00:00Z`)
+	assert.EqualError(t, checkCertificateExpiry(certificate(now.Add(-time.Hour), false), now), `certificate "etcd peer" expired at 2020-08-31T23:00:00Z`)
+}
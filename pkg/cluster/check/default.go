@@ -87,5 +87,17 @@ func ExtraClusterChecks() []ClusterCheck {
 				return K8sAllNodesSchedulableAssertion(ctx, cluster)
 			}, 2*time.Minute, 5*time.Second)
 		},
+		// warn if etcd DB size is approaching the quota, it doesn't affect the cluster readiness
+		func(cluster ClusterInfo) conditions.Condition {
+			return conditions.WarningCondition("etcd DB size to be within the quota", func(ctx context.Context) error {
+				return EtcdQuotaAssertion(ctx, cluster)
+			}, time.Minute)
+		},
+		// check that the certificates are not close to the expiry
+		func(cluster ClusterInfo) conditions.Condition {
+			return conditions.PollingCondition("certificates not to be close to the expiry", func(ctx context.Context) error {
+				return CertificateExpiryAssertion(ctx, cluster)
+			}, time.Minute, 5*time.Second)
+		},
 	}
 }
@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package check provides set of checks to verify cluster readiness.
+package check
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
+
+// EtcdQuotaThreshold is the share of the etcd space quota above which
+// EtcdQuotaAssertion fails.
+const EtcdQuotaThreshold = 0.8
+
+// EtcdQuotaAssertion checks whether the etcd DB size of the control plane
+// nodes is not approaching the space quota.
+//
+// ExtraClusterChecks reports the failure of this assertion as a warning, as
+// the cluster is still healthy until the quota is exceeded.
+//
+// Once the quota is exceeded, etcd raises the NOSPACE alarm and goes read-only
+// until it is defragmented and the alarm is disarmed.
+func EtcdQuotaAssertion(ctx context.Context, cluster ClusterInfo) error {
+	cli, err := cluster.Client()
+	if err != nil {
+		return err
+	}
+
+	nodes := append(cluster.NodesByType(machine.TypeInit), cluster.NodesByType(machine.TypeControlPlane)...)
+	nodesCtx := client.WithNodes(ctx, nodes...)
+
+	resp, err := cli.EtcdStatus(nodesCtx)
+	if err != nil {
+		return err
+	}
+
+	// sort the messages so that errors returned are consistent
+	sort.Slice(resp.Messages, func(i, j int) bool {
+		return resp.Messages[i].Metadata.GetHostname() < resp.Messages[j].Metadata.GetHostname()
+	})
+
+	var multiErr *multierror.Error
+
+	for _, msg := range resp.Messages {
+		if err = checkEtcdQuota(msg.GetMemberStatus()); err != nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("%s: %w", msg.Metadata.GetHostname(), err))
+		}
+	}
+
+	return multiErr.ErrorOrNil()
+}
+
+func checkEtcdQuota(status *machineapi.EtcdMemberStatus) error {
+	quota := status.GetQuotaBackendBytes()
+	if quota <= 0 {
+		return nil
+	}
+
+	if float64(status.GetDbSize()) > EtcdQuotaThreshold*float64(quota) {
+		return fmt.Errorf("etcd DB size %d bytes is approaching the quota of %d bytes, defragment etcd or increase quota-backend-bytes", status.GetDbSize(), quota)
+	}
+
+	return nil
+}
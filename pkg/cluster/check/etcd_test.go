@@ -0,0 +1,24 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package check //nolint: testpackage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+func TestCheckEtcdQuota(t *testing.T) {
+	const quota = 5 << 30
+
+	threshold := int64(EtcdQuotaThreshold * quota)
+
+	assert.NoError(t, checkEtcdQuota(&machineapi.EtcdMemberStatus{DbSize: 1 << 20, QuotaBackendBytes: quota}))
+	assert.NoError(t, checkEtcdQuota(&machineapi.EtcdMemberStatus{DbSize: 1 << 20}))
+	assert.NoError(t, checkEtcdQuota(&machineapi.EtcdMemberStatus{DbSize: threshold, QuotaBackendBytes: quota}))
+	assert.Error(t, checkEtcdQuota(&machineapi.EtcdMemberStatus{DbSize: threshold + 1, QuotaBackendBytes: quota}))
+}
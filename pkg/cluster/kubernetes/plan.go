@@ -0,0 +1,195 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubernetes
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// minorHop is the upgrade between the minor versions.
+type minorHop struct {
+	from, to int
+}
+
+// hop describes the changes of the control plane specs required by the minor
+// hop besides the image update.
+type hop struct {
+	// hyperkube means that the source version runs the hyperkube image which
+	// is replaced with the distroless images.
+	hyperkube bool
+}
+
+// supportedHops lists the supported upgrades of the 1.x control plane.
+var supportedHops = map[minorHop]hop{
+	{from: 18, to: 19}: {hyperkube: true},
+	{from: 19, to: 19}: {},
+}
+
+// component is the control plane component updated by the upgrade.
+type component struct {
+	name         string
+	image        string
+	controlPlane bool
+}
+
+// components are updated in the order of the list, one at a time.
+var components = []component{
+	{name: kubeAPIServer, image: constants.KubernetesAPIServerImage, controlPlane: true},
+	{name: kubeControllerManager, image: constants.KubernetesControllerManagerImage, controlPlane: true},
+	{name: kubeScheduler, image: constants.KubernetesSchedulerImage, controlPlane: true},
+	{name: kubeProxy, image: constants.KubernetesProxyImage},
+}
+
+// Step is the update of a single control plane component daemonset.
+type Step struct {
+	// Component is the name of the daemonset.
+	Component string
+	// Image is the image of the target version.
+	Image string
+	// Hyperkube means that the daemonset is migrated from the hyperkube image.
+	Hyperkube bool
+	// ControlPlane means that the component runs only on the control plane
+	// nodes.
+	ControlPlane bool
+}
+
+// String implements fmt.Stringer.
+func (step Step) String() string {
+	s := fmt.Sprintf("update %q to %q", step.Component, step.Image)
+
+	if step.Hyperkube {
+		s += " (migrating from hyperkube)"
+	}
+
+	if step.Component == kubeAPIServer {
+		s += " with pod-checkpointer grace period disabled"
+	}
+
+	return s
+}
+
+// Plan is the sequence of steps which upgrades the control plane.
+type Plan struct {
+	FromVersion string
+	ToVersion   string
+
+	Steps []Step
+}
+
+// NewPlan builds the upgrade plan between the versions.
+//
+// The control plane is upgraded by one minor version at most, the hop should
+// be listed as supported.
+func NewPlan(arch, fromVersion, toVersion string) (*Plan, error) {
+	from, err := parseVersion(fromVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := parseVersion(toVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if to.less(from) {
+		return nil, fmt.Errorf("downgrade from %q to %q is not supported", from, to)
+	}
+
+	if from.major != 1 || to.major != 1 {
+		return nil, fmt.Errorf("unsupported upgrade from %q to %q", from, to)
+	}
+
+	if to.minor-from.minor > 1 {
+		return nil, fmt.Errorf("upgrade from %q to %q skips minor versions, upgrade one minor version at a time", from, to)
+	}
+
+	h, ok := supportedHops[minorHop{from: from.minor, to: to.minor}]
+	if !ok {
+		return nil, fmt.Errorf("unsupported upgrade from %q to %q", from, to)
+	}
+
+	plan := &Plan{
+		FromVersion: from.String(),
+		ToVersion:   to.String(),
+	}
+
+	for _, c := range components {
+		plan.Steps = append(plan.Steps, Step{
+			Component:    c.name,
+			Image:        fmt.Sprintf("%s-%s:v%s", c.image, arch, to),
+			Hyperkube:    h.hyperkube,
+			ControlPlane: c.controlPlane,
+		})
+	}
+
+	return plan, nil
+}
+
+// Print the plan to the writer.
+func (plan *Plan) Print(w io.Writer, prePullImages bool) {
+	fmt.Fprintf(w, "upgrade plan %s -> %s:\n", plan.FromVersion, plan.ToVersion)
+
+	n := 1
+
+	if prePullImages {
+		for _, step := range plan.Steps {
+			fmt.Fprintf(w, "  %d. pre-pull %q\n", n, step.Image)
+
+			n++
+		}
+	}
+
+	for _, step := range plan.Steps {
+		fmt.Fprintf(w, "  %d. %s\n", n, step)
+
+		n++
+	}
+}
+
+type version struct {
+	major, minor, patch int
+}
+
+func parseVersion(v string) (version, error) {
+	parts := strings.Split(strings.TrimPrefix(v, "v"), ".")
+	if len(parts) != 3 {
+		return version{}, fmt.Errorf("error parsing version %q: expected major.minor.patch", v)
+	}
+
+	var (
+		result version
+		err    error
+	)
+
+	for i, dest := range []*int{&result.major, &result.minor, &result.patch} {
+		if *dest, err = strconv.Atoi(parts[i]); err != nil {
+			return version{}, fmt.Errorf("error parsing version %q: %w", v, err)
+		}
+	}
+
+	return result, nil
+}
+
+func (v version) less(other version) bool {
+	if v.major != other.major {
+		return v.major < other.major
+	}
+
+	if v.minor != other.minor {
+		return v.minor < other.minor
+	}
+
+	return v.patch < other.patch
+}
+
+// String implements fmt.Stringer.
+func (v version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.major, v.minor, v.patch)
+}
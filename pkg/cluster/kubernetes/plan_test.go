@@ -0,0 +1,60 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubernetes_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/cluster/kubernetes"
+)
+
+func TestNewPlan(t *testing.T) {
+	plan, err := kubernetes.NewPlan("amd64", "1.18.6", "v1.19.1")
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.18.6", plan.FromVersion)
+	assert.Equal(t, "1.19.1", plan.ToVersion)
+	assert.Equal(t, []kubernetes.Step{
+		{Component: "kube-apiserver", Image: "k8s.gcr.io/kube-apiserver-amd64:v1.19.1", Hyperkube: true, ControlPlane: true},
+		{Component: "kube-controller-manager", Image: "k8s.gcr.io/kube-controller-manager-amd64:v1.19.1", Hyperkube: true, ControlPlane: true},
+		{Component: "kube-scheduler", Image: "k8s.gcr.io/kube-scheduler-amd64:v1.19.1", Hyperkube: true, ControlPlane: true},
+		{Component: "kube-proxy", Image: "k8s.gcr.io/kube-proxy-amd64:v1.19.1", Hyperkube: true},
+	}, plan.Steps)
+
+	plan, err = kubernetes.NewPlan("arm64", "1.19.0", "1.19.1")
+	require.NoError(t, err)
+
+	assert.Len(t, plan.Steps, 4)
+	assert.Equal(t, "k8s.gcr.io/kube-apiserver-arm64:v1.19.1", plan.Steps[0].Image)
+	assert.False(t, plan.Steps[0].Hyperkube)
+
+	var buf bytes.Buffer
+
+	plan.Print(&buf, false)
+
+	assert.Equal(t, `upgrade plan 1.19.0 -> 1.19.1:
+  1. update "kube-apiserver" to "k8s.gcr.io/kube-apiserver-arm64:v1.19.1" with pod-checkpointer grace period disabled
+  2. update "kube-controller-manager" to "k8s.gcr.io/kube-controller-manager-arm64:v1.19.1"
+  3. update "kube-scheduler" to "k8s.gcr.io/kube-scheduler-arm64:v1.19.1"
+  4. update "kube-proxy" to "k8s.gcr.io/kube-proxy-arm64:v1.19.1"
+`, buf.String())
+
+	for _, tt := range []struct {
+		from, to string
+		err      string
+	}{
+		{"1.19.1", "1.19.0", `downgrade from "1.19.1" to "1.19.0" is not supported`},
+		{"1.18.6", "1.20.0", `upgrade from "1.18.6" to "1.20.0" skips minor versions, upgrade one minor version at a time`},
+		{"1.17.3", "1.18.6", `unsupported upgrade from "1.17.3" to "1.18.6"`},
+		{"1.19", "1.19.1", `error parsing version "1.19": expected major.minor.patch`},
+	} {
+		_, err = kubernetes.NewPlan("amd64", tt.from, tt.to)
+		assert.EqualError(t, err, tt.err)
+	}
+}
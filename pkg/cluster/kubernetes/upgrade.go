@@ -8,10 +8,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"runtime"
 	"strings"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
@@ -20,6 +24,7 @@ import (
 	"github.com/talos-systems/go-retry/retry"
 
 	"github.com/talos-systems/talos/pkg/cluster"
+	"github.com/talos-systems/talos/pkg/machinery/client"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
@@ -31,52 +36,219 @@ const (
 	kubeControllerManager = "kube-controller-manager"
 	kubeScheduler         = "kube-scheduler"
 	kubeProxy             = "kube-proxy"
+	podCheckpointer       = "pod-checkpointer"
+
+	podCheckpointerDefaultGracePeriod = "5m0s"
 )
 
-// Upgrade the Kubernetes control plane.
-func Upgrade(ctx context.Context, cluster cluster.K8sProvider, arch, fromVersion, toVersion string) error {
-	switch {
-	case strings.HasPrefix(fromVersion, "1.18.") && strings.HasPrefix(toVersion, "1.19."):
-		return hyperkubeUpgrade(ctx, cluster, arch, toVersion)
-	case strings.HasPrefix(fromVersion, "1.19.") && strings.HasPrefix(toVersion, "1.19."):
-		return hyperkubeUpgrade(ctx, cluster, arch, toVersion)
-	default:
-		return fmt.Errorf("unsupported upgrade from %q to %q", fromVersion, toVersion)
+// UpgradeProvider are the cluster interfaces required by the upgrade.
+type UpgradeProvider interface {
+	cluster.ClientProvider
+	cluster.K8sProvider
+}
+
+// UpgradeOptions for the Kubernetes control plane upgrade.
+type UpgradeOptions struct {
+	FromVersion  string
+	ToVersion    string
+	Architecture string
+
+	// DryRun prints the upgrade plan without applying it.
+	DryRun bool
+	// PrePullImages pulls the images of the target version on the nodes
+	// before any component is updated.
+	PrePullImages bool
+	// Rollback restores the spec of the component which fails the readiness
+	// check.
+	Rollback bool
+
+	// ReadinessTimeout is the time each component has to become ready once
+	// its daemonset is updated.
+	ReadinessTimeout time.Duration
+
+	Output io.Writer
+}
+
+// DefaultUpgradeOptions returns the default upgrade options.
+func DefaultUpgradeOptions() *UpgradeOptions {
+	return &UpgradeOptions{
+		Architecture: runtime.GOARCH,
+
+		PrePullImages: true,
+		Rollback:      true,
+
+		ReadinessTimeout: 5 * time.Minute,
+
+		Output: os.Stdout,
 	}
 }
 
-// hyperkubeUpgrade upgrades from hyperkube-based to distroless images in 1.19.
-func hyperkubeUpgrade(ctx context.Context, cluster cluster.K8sProvider, arch, targetVersion string) error {
+// Upgrade the Kubernetes control plane.
+//
+// The components are updated one at a time following the plan built for the
+// versions, each component has to become ready before the next one is
+// updated.
+func Upgrade(ctx context.Context, cluster UpgradeProvider, options *UpgradeOptions) error {
+	plan, err := NewPlan(options.Architecture, options.FromVersion, options.ToVersion)
+	if err != nil {
+		return err
+	}
+
+	plan.Print(options.Output, options.PrePullImages)
+
+	if options.DryRun {
+		return nil
+	}
+
 	clientset, err := cluster.K8sClient(ctx)
 	if err != nil {
 		return fmt.Errorf("error building K8s client: %w", err)
 	}
 
-	if err = podCheckpointerGracePeriod(ctx, clientset, "0m"); err != nil {
-		return fmt.Errorf("error setting pod-checkpointer grace period: %w", err)
+	if options.PrePullImages {
+		if err = prePullImages(ctx, cluster, clientset, plan, options.Output); err != nil {
+			return err
+		}
+	}
+
+	for _, step := range plan.Steps {
+		if err = upgradeComponent(ctx, clientset, step, options); err != nil {
+			return fmt.Errorf("failed updating daemonset %q: %w", step.Component, err)
+		}
 	}
 
-	graceTimeout := 5 * time.Minute
+	return nil
+}
+
+// prePullImages pulls the images of the plan on the nodes which run the
+// components, so that the updated pods don't wait for the image pull.
+func prePullImages(ctx context.Context, cluster cluster.ClientProvider, clientset *kubernetes.Clientset, plan *Plan, w io.Writer) error {
+	c, err := cluster.Client()
+	if err != nil {
+		return err
+	}
 
-	fmt.Printf("sleeping %s to let the pod-checkpointer self-checkpoint be updated\n", graceTimeout.String())
-	time.Sleep(graceTimeout)
+	nodes, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing nodes: %w", err)
+	}
 
-	daemonsets := []string{kubeAPIServer, kubeControllerManager, kubeScheduler, kubeProxy}
+	var allNodes, controlPlaneNodes []string
 
-	for _, ds := range daemonsets {
-		if err = hyperkubeUpgradeDs(ctx, clientset, ds, arch, targetVersion); err != nil {
-			return fmt.Errorf("failed updating daemonset %q: %w", ds, err)
+	for _, node := range nodes.Items {
+		for _, address := range node.Status.Addresses {
+			if address.Type != corev1.NodeInternalIP {
+				continue
+			}
+
+			allNodes = append(allNodes, address.Address)
+
+			if _, ok := node.Labels[constants.LabelNodeRoleMaster]; ok {
+				controlPlaneNodes = append(controlPlaneNodes, address.Address)
+			}
+
+			break
 		}
 	}
 
-	if err = podCheckpointerGracePeriod(ctx, clientset, graceTimeout.String()); err != nil {
-		return fmt.Errorf("error setting pod-checkpointer grace period: %w", err)
+	for _, step := range plan.Steps {
+		targets := allNodes
+		if step.ControlPlane {
+			targets = controlPlaneNodes
+		}
+
+		fmt.Fprintf(w, "pre-pulling %q on %v\n", step.Image, targets)
+
+		if _, err = c.ImagePull(client.WithNodes(ctx, targets...), step.Image); err != nil {
+			return fmt.Errorf("error pre-pulling %q: %w", step.Image, err)
+		}
 	}
 
 	return nil
 }
 
+// upgradeComponent updates the daemonset of the component and waits for it to
+// become ready.
+//
+// If the component doesn't become ready, the daemonset spec is restored when
+// the rollback is enabled.
+//
 //nolint: gocyclo
+func upgradeComponent(ctx context.Context, clientset *kubernetes.Clientset, step Step, options *UpgradeOptions) (err error) {
+	daemonset, err := clientset.AppsV1().DaemonSets(namespace).Get(ctx, step.Component, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error fetching daemonset: %w", err)
+	}
+
+	backup := daemonset.Spec.Template.DeepCopy()
+
+	if step.Component == kubeAPIServer {
+		// pod-checkpointer would keep the checkpoint of the old kube-apiserver
+		// running for the grace period
+		if err = podCheckpointerGracePeriod(ctx, clientset, "0m", options); err != nil {
+			return fmt.Errorf("error setting pod-checkpointer grace period: %w", err)
+		}
+
+		defer func() {
+			if restoreErr := podCheckpointerGracePeriod(ctx, clientset, podCheckpointerDefaultGracePeriod, options); restoreErr != nil && err == nil {
+				err = fmt.Errorf("error setting pod-checkpointer grace period: %w", restoreErr)
+			}
+		}()
+
+		fmt.Fprintf(options.Output, "temporarily taking %q out of pod-checkpointer control\n", step.Component)
+
+		if err = updateDaemonset(ctx, clientset, step.Component, func(daemonset *appsv1.DaemonSet) error {
+			delete(daemonset.Spec.Template.Annotations, checkpointerAnnotation)
+
+			return nil
+		}); err == nil {
+			err = waitDaemonset(ctx, clientset, step.Component, options.ReadinessTimeout)
+		}
+
+		if err != nil {
+			return rollbackComponent(ctx, clientset, step.Component, backup, options, err)
+		}
+	}
+
+	fmt.Fprintf(options.Output, "updating daemonset %q to %q\n", step.Component, step.Image)
+
+	if err = updateDaemonset(ctx, clientset, step.Component, func(daemonset *appsv1.DaemonSet) error {
+		return updateComponentSpec(daemonset, step)
+	}); err != nil {
+		return rollbackComponent(ctx, clientset, step.Component, backup, options, err)
+	}
+
+	if err = waitDaemonset(ctx, clientset, step.Component, options.ReadinessTimeout); err != nil {
+		return rollbackComponent(ctx, clientset, step.Component, backup, options, err)
+	}
+
+	return nil
+}
+
+// rollbackComponent restores the pod template of the component daemonset
+// after the update failed with err.
+func rollbackComponent(ctx context.Context, clientset *kubernetes.Clientset, ds string, backup *corev1.PodTemplateSpec, options *UpgradeOptions, err error) error {
+	if !options.Rollback {
+		return err
+	}
+
+	fmt.Fprintf(options.Output, "rolling back daemonset %q: %s\n", ds, err)
+
+	if rollbackErr := updateDaemonset(ctx, clientset, ds, func(daemonset *appsv1.DaemonSet) error {
+		daemonset.Spec.Template = *backup
+
+		return nil
+	}); rollbackErr != nil {
+		return fmt.Errorf("%w, rollback failed: %s", err, rollbackErr)
+	}
+
+	if rollbackErr := waitDaemonset(ctx, clientset, ds, options.ReadinessTimeout); rollbackErr != nil {
+		return fmt.Errorf("%w, rolled back component is not ready: %s", err, rollbackErr)
+	}
+
+	return fmt.Errorf("%w, rolled back", err)
+}
+
 func updateDaemonset(ctx context.Context, clientset *kubernetes.Clientset, ds string, updateFunc func(daemonset *appsv1.DaemonSet) error) error {
 	daemonset, err := clientset.AppsV1().DaemonSets(namespace).Get(ctx, ds, metav1.GetOptions{})
 	if err != nil {
@@ -109,39 +281,59 @@ func updateDaemonset(ctx context.Context, clientset *kubernetes.Clientset, ds st
 		return fmt.Errorf("error patching deployment: %w", err)
 	}
 
-	// give k8s some time
-	time.Sleep(10 * time.Second)
+	return nil
+}
+
+// waitDaemonset polls the daemonset status until the updated pods are ready on
+// all the nodes.
+func waitDaemonset(ctx context.Context, clientset *kubernetes.Clientset, ds string, timeout time.Duration) error {
+	return retry.Constant(timeout, retry.WithUnits(5*time.Second)).Retry(func() error {
+		if ctx.Err() != nil {
+			return retry.UnexpectedError(ctx.Err())
+		}
 
-	return retry.Constant(5*time.Minute, retry.WithUnits(10*time.Second)).Retry(func() error {
-		daemonset, err = clientset.AppsV1().DaemonSets(namespace).Get(ctx, ds, metav1.GetOptions{})
+		daemonset, err := clientset.AppsV1().DaemonSets(namespace).Get(ctx, ds, metav1.GetOptions{})
 		if err != nil {
 			return retry.UnexpectedError(fmt.Errorf("error fetching daemonset: %w", err))
 		}
 
-		if daemonset.Status.UpdatedNumberScheduled != daemonset.Status.DesiredNumberScheduled {
-			return retry.ExpectedError(fmt.Errorf("expected current number up-to-date for %s to be %d, got %d", ds, daemonset.Status.UpdatedNumberScheduled, daemonset.Status.CurrentNumberScheduled))
-		}
+		return daemonsetReady(daemonset)
+	})
+}
 
-		if daemonset.Status.CurrentNumberScheduled != daemonset.Status.DesiredNumberScheduled {
-			return retry.ExpectedError(fmt.Errorf("expected current number scheduled for %s to be %d, got %d", ds, daemonset.Status.DesiredNumberScheduled, daemonset.Status.CurrentNumberScheduled))
-		}
+func daemonsetReady(daemonset *appsv1.DaemonSet) error {
+	ds := daemonset.Name
 
-		if daemonset.Status.NumberAvailable != daemonset.Status.DesiredNumberScheduled {
-			return retry.ExpectedError(fmt.Errorf("expected number available for %s to be %d, got %d", ds, daemonset.Status.DesiredNumberScheduled, daemonset.Status.NumberAvailable))
-		}
+	if daemonset.Status.ObservedGeneration < daemonset.Generation {
+		return retry.ExpectedError(fmt.Errorf("expected observed generation for %s to be %d, got %d", ds, daemonset.Generation, daemonset.Status.ObservedGeneration))
+	}
 
-		if daemonset.Status.NumberReady != daemonset.Status.DesiredNumberScheduled {
-			return retry.ExpectedError(fmt.Errorf("expected number ready for %s to be %d, got %d", ds, daemonset.Status.DesiredNumberScheduled, daemonset.Status.NumberReady))
-		}
+	if daemonset.Status.UpdatedNumberScheduled != daemonset.Status.DesiredNumberScheduled {
+		return retry.ExpectedError(fmt.Errorf("expected current number up-to-date for %s to be %d, got %d", ds, daemonset.Status.DesiredNumberScheduled, daemonset.Status.UpdatedNumberScheduled))
+	}
 
-		return nil
-	})
+	if daemonset.Status.CurrentNumberScheduled != daemonset.Status.DesiredNumberScheduled {
+		return retry.ExpectedError(fmt.Errorf("expected current number scheduled for %s to be %d, got %d", ds, daemonset.Status.DesiredNumberScheduled, daemonset.Status.CurrentNumberScheduled))
+	}
+
+	if daemonset.Status.NumberAvailable != daemonset.Status.DesiredNumberScheduled {
+		return retry.ExpectedError(fmt.Errorf("expected number available for %s to be %d, got %d", ds, daemonset.Status.DesiredNumberScheduled, daemonset.Status.NumberAvailable))
+	}
+
+	if daemonset.Status.NumberReady != daemonset.Status.DesiredNumberScheduled {
+		return retry.ExpectedError(fmt.Errorf("expected number ready for %s to be %d, got %d", ds, daemonset.Status.DesiredNumberScheduled, daemonset.Status.NumberReady))
+	}
+
+	return nil
 }
 
-func podCheckpointerGracePeriod(ctx context.Context, clientset *kubernetes.Clientset, gracePeriod string) error {
-	fmt.Printf("updating pod-checkpointer grace period to %q\n", gracePeriod)
+// podCheckpointerGracePeriod updates the grace period of the pod-checkpointer
+// and waits for the updated pod-checkpointer to be ready, so that it
+// checkpoints itself with the new grace period.
+func podCheckpointerGracePeriod(ctx context.Context, clientset *kubernetes.Clientset, gracePeriod string, options *UpgradeOptions) error {
+	fmt.Fprintf(options.Output, "updating pod-checkpointer grace period to %q\n", gracePeriod)
 
-	return updateDaemonset(ctx, clientset, "pod-checkpointer", func(daemonset *appsv1.DaemonSet) error {
+	if err := updateDaemonset(ctx, clientset, podCheckpointer, func(daemonset *appsv1.DaemonSet) error {
 		if len(daemonset.Spec.Template.Spec.Containers) != 1 {
 			return fmt.Errorf("unexpected number of containers: %d", len(daemonset.Spec.Template.Spec.Containers))
 		}
@@ -154,61 +346,42 @@ func podCheckpointerGracePeriod(ctx context.Context, clientset *kubernetes.Clien
 		}
 
 		return nil
-	})
-}
-
-//nolint: gocyclo
-func hyperkubeUpgradeDs(ctx context.Context, clientset *kubernetes.Clientset, ds, arch, targetVersion string) error {
-	if ds == kubeAPIServer {
-		fmt.Printf("temporarily taking %q out of pod-checkpointer control\n", ds)
-
-		if err := updateDaemonset(ctx, clientset, ds, func(daemonset *appsv1.DaemonSet) error {
-			delete(daemonset.Spec.Template.Annotations, checkpointerAnnotation)
-
-			return nil
-		}); err != nil {
-			return err
-		}
+	}); err != nil {
+		return err
 	}
 
-	fmt.Printf("updating daemonset %q to version %q\n", ds, targetVersion)
+	return waitDaemonset(ctx, clientset, podCheckpointer, options.ReadinessTimeout)
+}
 
-	return updateDaemonset(ctx, clientset, ds, func(daemonset *appsv1.DaemonSet) error {
-		if len(daemonset.Spec.Template.Spec.Containers) != 1 {
-			return fmt.Errorf("unexpected number of containers: %d", len(daemonset.Spec.Template.Spec.Containers))
-		}
+// updateComponentSpec updates the container of the component to the image of
+// the step.
+func updateComponentSpec(daemonset *appsv1.DaemonSet, step Step) error {
+	if len(daemonset.Spec.Template.Spec.Containers) != 1 {
+		return fmt.Errorf("unexpected number of containers: %d", len(daemonset.Spec.Template.Spec.Containers))
+	}
 
-		args := daemonset.Spec.Template.Spec.Containers[0].Command
-		if args[0] == "./hyperkube" || args[0] == "/hyperkube" {
-			args[0] = "/go-runner"
-			args[1] = fmt.Sprintf("/usr/local/bin/%s", ds)
+	container := &daemonset.Spec.Template.Spec.Containers[0]
 
-			if ds == kubeProxy {
-				daemonset.Spec.Template.Spec.Containers[0].Command = daemonset.Spec.Template.Spec.Containers[0].Command[1:]
-			}
-		}
+	// the hyperkube command is replaced even if the version doesn't change,
+	// so that the interrupted migration can be finished
+	if len(container.Command) > 1 && (container.Command[0] == "./hyperkube" || container.Command[0] == "/hyperkube") {
+		container.Command[0] = "/go-runner"
+		container.Command[1] = fmt.Sprintf("/usr/local/bin/%s", step.Component)
 
-		switch ds {
-		case kubeAPIServer:
-			daemonset.Spec.Template.Spec.Containers[0].Image = fmt.Sprintf("%s-%s:v%s", constants.KubernetesAPIServerImage, arch, targetVersion)
-		case kubeControllerManager:
-			daemonset.Spec.Template.Spec.Containers[0].Image = fmt.Sprintf("%s-%s:v%s", constants.KubernetesControllerManagerImage, arch, targetVersion)
-		case kubeScheduler:
-			daemonset.Spec.Template.Spec.Containers[0].Image = fmt.Sprintf("%s-%s:v%s", constants.KubernetesSchedulerImage, arch, targetVersion)
-		case kubeProxy:
-			daemonset.Spec.Template.Spec.Containers[0].Image = fmt.Sprintf("%s-%s:v%s", constants.KubernetesProxyImage, arch, targetVersion)
-		default:
-			return fmt.Errorf("failed to build new image spec")
+		if step.Component == kubeProxy {
+			container.Command = container.Command[1:]
 		}
+	}
 
-		if ds == kubeAPIServer {
-			if daemonset.Spec.Template.Annotations == nil {
-				daemonset.Spec.Template.Annotations = make(map[string]string)
-			}
+	container.Image = step.Image
 
-			daemonset.Spec.Template.Annotations[checkpointerAnnotation] = "true"
+	if step.Component == kubeAPIServer {
+		if daemonset.Spec.Template.Annotations == nil {
+			daemonset.Spec.Template.Annotations = make(map[string]string)
 		}
 
-		return nil
-	})
+		daemonset.Spec.Template.Annotations[checkpointerAnnotation] = "true"
+	}
+
+	return nil
 }
@@ -0,0 +1,110 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package upgrade //nolint: testpackage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+type mockNodeClient struct {
+	bootIDs    map[string]string
+	running    map[string][]string
+	upgradeErr error
+	upgraded   []string
+}
+
+func node(ctx context.Context) string {
+	md, _ := metadata.FromOutgoingContext(ctx)
+
+	return md.Get("nodes")[0]
+}
+
+func (c *mockNodeClient) Upgrade(ctx context.Context, image string, preserve bool, callOptions ...grpc.CallOption) (*machineapi.UpgradeResponse, error) {
+	if c.upgradeErr != nil {
+		return nil, c.upgradeErr
+	}
+
+	c.upgraded = append(c.upgraded, node(ctx))
+
+	return &machineapi.UpgradeResponse{}, nil
+}
+
+func (c *mockNodeClient) Read(ctx context.Context, path string) (io.ReadCloser, <-chan error, error) {
+	bootID, ok := c.bootIDs[node(ctx)]
+	if !ok {
+		return nil, nil, errors.New("connection refused")
+	}
+
+	errCh := make(chan error)
+	close(errCh)
+
+	return ioutil.NopCloser(strings.NewReader(bootID)), errCh, nil
+}
+
+func (c *mockNodeClient) Sequences(ctx context.Context, callOptions ...grpc.CallOption) (*machineapi.SequencesResponse, error) {
+	sequences := &machineapi.Sequences{}
+
+	for _, seq := range c.running[node(ctx)] {
+		sequences.Running = append(sequences.Running, &machineapi.RunningSequence{Sequence: seq})
+	}
+
+	return &machineapi.SequencesResponse{Messages: []*machineapi.Sequences{sequences}}, nil
+}
+
+func TestStartBatch(t *testing.T) {
+	options := &Options{Image: "ghcr.io/talos-systems/installer:latest", Output: ioutil.Discard}
+
+	c := &mockNodeClient{bootIDs: map[string]string{"10.5.0.4": "a", "10.5.0.5": "b"}}
+	state := &State{}
+
+	require.NoError(t, startBatch(context.Background(), c, state, options, []string{"10.5.0.4", "10.5.0.5"}))
+	assert.Equal(t, []string{"10.5.0.4", "10.5.0.5"}, c.upgraded)
+	assert.Equal(t, map[string]string{"10.5.0.4": "a", "10.5.0.5": "b"}, state.InProgress)
+}
+
+func TestStartBatchUpgradeError(t *testing.T) {
+	options := &Options{Image: "ghcr.io/talos-systems/installer:latest", Output: ioutil.Discard}
+
+	c := &mockNodeClient{bootIDs: map[string]string{"10.5.0.4": "a"}, upgradeErr: errors.New("invalid image")}
+	state := &State{}
+
+	err := startBatch(context.Background(), c, state, options, []string{"10.5.0.4"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid image")
+
+	// the node is pending once again
+	assert.Empty(t, state.InProgress)
+	assert.Equal(t, [][]string{{"10.5.0.4"}}, Batches([]string{"10.5.0.4"}, state, 1))
+}
+
+func TestStartBatchResume(t *testing.T) {
+	options := &Options{Image: "ghcr.io/talos-systems/installer:latest", Output: ioutil.Discard}
+
+	// 10.5.0.4 hasn't rebooted and the upgrade is not running, 10.5.0.5 has rebooted,
+	// 10.5.0.6 is rebooting, 10.5.0.7 hasn't rebooted, but the upgrade is still running
+	c := &mockNodeClient{
+		bootIDs: map[string]string{"10.5.0.4": "a", "10.5.0.5": "c", "10.5.0.7": "e"},
+		running: map[string][]string{"10.5.0.4": {"bootstrap"}, "10.5.0.7": {"upgrade"}},
+	}
+	state := &State{InProgress: map[string]string{"10.5.0.4": "a", "10.5.0.5": "b", "10.5.0.6": "d", "10.5.0.7": "e"}}
+
+	require.NoError(t, startBatch(context.Background(), c, state, options, []string{"10.5.0.4", "10.5.0.5", "10.5.0.6", "10.5.0.7"}))
+
+	// the upgrade is issued once again only for the node which isn't upgrading
+	assert.Equal(t, []string{"10.5.0.4"}, c.upgraded)
+	assert.Equal(t, map[string]string{"10.5.0.4": "a", "10.5.0.5": "b", "10.5.0.6": "d", "10.5.0.7": "e"}, state.InProgress)
+}
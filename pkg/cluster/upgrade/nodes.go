@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package upgrade
+
+import (
+	"context"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/talos-systems/talos/pkg/cluster"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// Nodes implements cluster.Info for the nodes discovered in Kubernetes.
+//
+// The init node can't be told apart from the other control plane nodes, so
+// it is listed as a control plane node.
+type Nodes struct {
+	ControlPlaneNodes []string
+	WorkerNodes       []string
+}
+
+// Nodes implements cluster.Info.
+func (n *Nodes) Nodes() []string {
+	return append(append([]string(nil), n.ControlPlaneNodes...), n.WorkerNodes...)
+}
+
+// NodesByType implements cluster.Info.
+func (n *Nodes) NodesByType(t machine.Type) []string {
+	switch t {
+	case machine.TypeControlPlane:
+		return n.ControlPlaneNodes
+	case machine.TypeJoin:
+		return n.WorkerNodes
+	case machine.TypeInit, machine.TypeUnknown:
+		return nil
+	default:
+		panic("unsupported machine type")
+	}
+}
+
+// DiscoverNodes lists the cluster nodes by their internal IPs.
+func DiscoverNodes(ctx context.Context, cluster cluster.K8sProvider) (*Nodes, error) {
+	clientset, err := cluster.K8sClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := &Nodes{}
+
+	for _, node := range list.Items {
+		_, master := node.Labels[constants.LabelNodeRoleMaster]
+
+		for _, address := range node.Status.Addresses {
+			if address.Type != v1.NodeInternalIP {
+				continue
+			}
+
+			if master {
+				nodes.ControlPlaneNodes = append(nodes.ControlPlaneNodes, address.Address)
+			} else {
+				nodes.WorkerNodes = append(nodes.WorkerNodes, address.Address)
+			}
+
+			break
+		}
+	}
+
+	sort.Strings(nodes.ControlPlaneNodes)
+	sort.Strings(nodes.WorkerNodes)
+
+	return nodes, nil
+}
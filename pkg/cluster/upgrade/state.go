@@ -0,0 +1,89 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package upgrade
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	yaml "gopkg.in/yaml.v3"
+)
+
+// State is the progress of the rolling upgrade.
+type State struct {
+	Image    string   `yaml:"image"`
+	Upgraded []string `yaml:"upgraded,omitempty"`
+	// InProgress maps the nodes being upgraded to their boot ID before the
+	// upgrade.
+	InProgress map[string]string `yaml:"inProgress,omitempty"`
+}
+
+// LoadState reads the upgrade state from the file.
+func LoadState(path string) (*State, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading upgrade state: %w", err)
+	}
+
+	state := &State{}
+
+	if err = yaml.Unmarshal(b, state); err != nil {
+		return nil, fmt.Errorf("error parsing upgrade state: %w", err)
+	}
+
+	return state, nil
+}
+
+// Save writes the upgrade state to the file, the state isn't saved if the
+// path is empty.
+func (s *State) Save(path string) error {
+	if path == "" {
+		return nil
+	}
+
+	b, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+
+	if err = ioutil.WriteFile(tmp, b, 0o600); err != nil {
+		return fmt.Errorf("error saving upgrade state: %w", err)
+	}
+
+	return os.Rename(tmp, path)
+}
+
+func (s *State) upgraded(node string) bool {
+	for _, n := range s.Upgraded {
+		if n == node {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *State) start(node, bootID string) {
+	if s.InProgress == nil {
+		s.InProgress = map[string]string{}
+	}
+
+	s.InProgress[node] = bootID
+}
+
+func (s *State) abort(node string) {
+	delete(s.InProgress, node)
+}
+
+func (s *State) finish(node string) {
+	delete(s.InProgress, node)
+
+	if !s.upgraded(node) {
+		s.Upgraded = append(s.Upgraded, node)
+	}
+}
@@ -0,0 +1,319 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package upgrade implements the rolling upgrade of Talos clusters.
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/talos-systems/go-retry/retry"
+	"google.golang.org/grpc"
+
+	"github.com/talos-systems/talos/pkg/cluster/check"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
+
+// ErrPaused is returned when the upgrade is paused after the configured
+// number of nodes.
+var ErrPaused = errors.New("upgrade paused")
+
+// Options for the rolling upgrade.
+type Options struct {
+	Image    string
+	Preserve bool
+
+	// MaxUnavailable is the number of the worker nodes upgraded at the same
+	// time. The control plane nodes are always upgraded one by one to
+	// preserve the etcd quorum.
+	MaxUnavailable int
+	// PauseAfter pauses the upgrade once the number of nodes was upgraded,
+	// zero means that the upgrade doesn't pause.
+	PauseAfter int
+
+	NodeTimeout   time.Duration
+	HealthTimeout time.Duration
+
+	// StatePath is the file the upgrade progress is saved to.
+	StatePath string
+
+	Output   io.Writer
+	Reporter check.Reporter
+}
+
+// DefaultOptions returns the default upgrade options.
+func DefaultOptions() *Options {
+	return &Options{
+		MaxUnavailable: 1,
+
+		NodeTimeout:   15 * time.Minute,
+		HealthTimeout: 20 * time.Minute,
+
+		Output:   os.Stderr,
+		Reporter: check.StderrReporter(),
+	}
+}
+
+// Run performs the rolling upgrade of the cluster. The worker nodes are
+// upgraded first, then the control plane nodes. Each batch of nodes has to
+// reboot and pass the default cluster checks before the upgrade moves on.
+//
+// The progress is recorded in the state, so that an interrupted or paused
+// upgrade can be resumed.
+func Run(ctx context.Context, cluster check.ClusterInfo, state *State, options *Options) error {
+	if options.Image == "" {
+		return fmt.Errorf("installer image is required")
+	}
+
+	if options.MaxUnavailable < 1 {
+		return fmt.Errorf("max unavailable should be at least 1, got %d", options.MaxUnavailable)
+	}
+
+	if state.Image == "" {
+		state.Image = options.Image
+	}
+
+	if state.Image != options.Image {
+		return fmt.Errorf("upgrade to %q is in progress, can't upgrade to %q", state.Image, options.Image)
+	}
+
+	c, err := cluster.Client()
+	if err != nil {
+		return err
+	}
+
+	controlPlane := append(cluster.NodesByType(machine.TypeInit), cluster.NodesByType(machine.TypeControlPlane)...)
+
+	plan := append(
+		Batches(cluster.NodesByType(machine.TypeJoin), state, options.MaxUnavailable),
+		Batches(controlPlane, state, 1)...,
+	)
+
+	upgraded := 0
+
+	for _, batch := range plan {
+		if options.PauseAfter > 0 && upgraded >= options.PauseAfter {
+			return ErrPaused
+		}
+
+		if err = upgradeBatch(ctx, c, cluster, state, options, batch); err != nil {
+			return err
+		}
+
+		upgraded += len(batch)
+	}
+
+	return nil
+}
+
+// nodeClient is the part of the Talos API client used by the upgrade.
+type nodeClient interface {
+	Upgrade(ctx context.Context, image string, preserve bool, callOptions ...grpc.CallOption) (*machineapi.UpgradeResponse, error)
+	Read(ctx context.Context, path string) (io.ReadCloser, <-chan error, error)
+	Sequences(ctx context.Context, callOptions ...grpc.CallOption) (*machineapi.SequencesResponse, error)
+}
+
+func upgradeBatch(ctx context.Context, c *client.Client, cluster check.ClusterInfo, state *State, options *Options, batch []string) error {
+	if err := startBatch(ctx, c, state, options, batch); err != nil {
+		return err
+	}
+
+	for _, node := range batch {
+		if err := waitRebooted(ctx, c, node, state.InProgress[node], options.NodeTimeout); err != nil {
+			return err
+		}
+	}
+
+	healthCtx, healthCtxCancel := context.WithTimeout(ctx, options.HealthTimeout)
+	defer healthCtxCancel()
+
+	if err := check.Wait(healthCtx, cluster, check.DefaultClusterChecks(), options.Reporter); err != nil {
+		return fmt.Errorf("cluster is not healthy after upgrading %v: %w", batch, err)
+	}
+
+	for _, node := range batch {
+		state.finish(node)
+	}
+
+	return state.Save(options.StatePath)
+}
+
+// startBatch issues the upgrade of the batch nodes.
+//
+// The upgrade of a resumed node is issued once again only if the node hasn't
+// rebooted since and the upgrade sequence is not running on it, as the
+// upgrade might have failed or never been issued. If the upgrade can't be
+// issued, the node is reverted to pending.
+func startBatch(ctx context.Context, c nodeClient, state *State, options *Options, batch []string) error {
+	for _, node := range batch {
+		nodeCtx := client.WithNodes(ctx, node)
+
+		bootID, err := readBootID(nodeCtx, c)
+
+		if bootIDBefore, inProgress := state.InProgress[node]; inProgress {
+			if err != nil || bootID != bootIDBefore {
+				// the node is rebooting or has already rebooted
+				fmt.Fprintf(options.Output, "resuming upgrade of node %s\n", node)
+
+				continue
+			}
+
+			var upgrading bool
+
+			if upgrading, err = isUpgrading(nodeCtx, c); err != nil {
+				return fmt.Errorf("error reading running sequences of node %s: %w", node, err)
+			}
+
+			if upgrading {
+				// the upgrade issued before is still running
+				fmt.Fprintf(options.Output, "node %s is still upgrading, resuming upgrade\n", node)
+
+				continue
+			}
+
+			fmt.Fprintf(options.Output, "node %s hasn't rebooted, upgrading it once again\n", node)
+		} else {
+			if err != nil {
+				return fmt.Errorf("error reading boot ID of node %s: %w", node, err)
+			}
+
+			fmt.Fprintf(options.Output, "upgrading node %s\n", node)
+
+			// the boot ID is saved before the upgrade starts, so that a resumed
+			// upgrade doesn't upgrade the node once again
+			state.start(node, bootID)
+
+			if err = state.Save(options.StatePath); err != nil {
+				return err
+			}
+		}
+
+		if _, err = c.Upgrade(nodeCtx, options.Image, options.Preserve); err != nil {
+			state.abort(node)
+
+			if saveErr := state.Save(options.StatePath); saveErr != nil {
+				return saveErr
+			}
+
+			return fmt.Errorf("error upgrading node %s: %w", node, err)
+		}
+	}
+
+	return nil
+}
+
+// isUpgrading checks whether the upgrade sequence is running on the node.
+func isUpgrading(ctx context.Context, c nodeClient) (bool, error) {
+	resp, err := c.Sequences(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, msg := range resp.GetMessages() {
+		for _, seq := range msg.GetRunning() {
+			if seq.GetSequence() == "upgrade" {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// waitRebooted waits for the boot ID of the node to change.
+func waitRebooted(ctx context.Context, c nodeClient, node, bootIDBefore string, timeout time.Duration) error {
+	nodeCtx := client.WithNodes(ctx, node)
+
+	err := retry.Constant(timeout, retry.WithUnits(10*time.Second)).Retry(func() error {
+		if ctx.Err() != nil {
+			return retry.UnexpectedError(ctx.Err())
+		}
+
+		bootID, err := readBootID(nodeCtx, c)
+		if err != nil {
+			// API is unresponsive while the node reboots
+			return retry.ExpectedError(err)
+		}
+
+		if bootID == bootIDBefore {
+			return retry.ExpectedError(fmt.Errorf("node %s hasn't rebooted yet", node))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for node %s to reboot: %w", node, err)
+	}
+
+	return nil
+}
+
+func readBootID(ctx context.Context, c nodeClient) (string, error) {
+	// a short timeout works around the rebooting node not answering requests
+	reqCtx, reqCtxCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer reqCtxCancel()
+
+	reader, errCh, err := c.Read(reqCtx, "/proc/sys/kernel/random/boot_id")
+	if err != nil {
+		return "", err
+	}
+
+	defer reader.Close() //nolint: errcheck
+
+	body, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+
+	for err = range errCh {
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return string(body), reader.Close()
+}
+
+// Batches splits the nodes which are not upgraded yet into the batches of
+// the size. The nodes which were being upgraded when the upgrade was
+// interrupted come first.
+func Batches(nodes []string, state *State, size int) [][]string {
+	var pending []string
+
+	for _, node := range nodes {
+		if _, ok := state.InProgress[node]; ok {
+			pending = append(pending, node)
+		}
+	}
+
+	for _, node := range nodes {
+		if _, ok := state.InProgress[node]; ok || state.upgraded(node) {
+			continue
+		}
+
+		pending = append(pending, node)
+	}
+
+	var batches [][]string
+
+	for len(pending) > 0 {
+		n := size
+		if n > len(pending) {
+			n = len(pending)
+		}
+
+		batches = append(batches, pending[:n])
+		pending = pending[n:]
+	}
+
+	return batches
+}
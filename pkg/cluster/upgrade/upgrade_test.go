@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package upgrade_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/cluster/upgrade"
+)
+
+func TestBatches(t *testing.T) {
+	nodes := []string{"10.5.0.4", "10.5.0.5", "10.5.0.6", "10.5.0.7", "10.5.0.8"}
+
+	assert.Equal(t, [][]string{{"10.5.0.4", "10.5.0.5"}, {"10.5.0.6", "10.5.0.7"}, {"10.5.0.8"}}, upgrade.Batches(nodes, &upgrade.State{}, 2))
+
+	assert.Equal(t, [][]string{{"10.5.0.4"}, {"10.5.0.5"}, {"10.5.0.6"}, {"10.5.0.7"}, {"10.5.0.8"}}, upgrade.Batches(nodes, &upgrade.State{}, 1))
+
+	state := &upgrade.State{
+		Upgraded:   []string{"10.5.0.4", "10.5.0.5"},
+		InProgress: map[string]string{"10.5.0.7": "bootid"},
+	}
+
+	assert.Equal(t, [][]string{{"10.5.0.7", "10.5.0.6"}, {"10.5.0.8"}}, upgrade.Batches(nodes, state, 2))
+
+	assert.Nil(t, upgrade.Batches(nodes[:2], state, 2))
+}
+
+func TestState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "talos")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	path := filepath.Join(dir, "upgrade.yaml")
+
+	state := &upgrade.State{
+		Image:      "ghcr.io/talos-systems/installer:latest",
+		Upgraded:   []string{"10.5.0.4"},
+		InProgress: map[string]string{"10.5.0.5": "bootid"},
+	}
+
+	require.NoError(t, state.Save(path))
+
+	loaded, err := upgrade.LoadState(path)
+	require.NoError(t, err)
+	assert.Equal(t, state, loaded)
+
+	require.NoError(t, (&upgrade.State{}).Save(""))
+}
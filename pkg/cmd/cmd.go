@@ -7,6 +7,8 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
 	"os/exec"
 
 	"github.com/armon/circbuf"
@@ -24,8 +26,36 @@ func Run(name string, args ...string) (string, error) {
 
 // RunContext executes a command with context.
 func RunContext(ctx context.Context, name string, args ...string) (string, error) {
+	return RunWithOptions(ctx, name, args)
+}
+
+// Option is the functional option func.
+type Option func(*exec.Cmd)
+
+// WithStdin sets the standard input of the command.
+func WithStdin(stdin io.Reader) Option {
+	return func(cmd *exec.Cmd) {
+		cmd.Stdin = stdin
+	}
+}
+
+// WithExtraFiles passes additional open files to the command.
+//
+// Files are available to the command as file descriptors 3, 4 and so on.
+func WithExtraFiles(files ...*os.File) Option {
+	return func(cmd *exec.Cmd) {
+		cmd.ExtraFiles = append(cmd.ExtraFiles, files...)
+	}
+}
+
+// RunWithOptions executes a command with context and options.
+func RunWithOptions(ctx context.Context, name string, args []string, setters ...Option) (string, error) {
 	cmd := exec.CommandContext(ctx, name, args...)
 
+	for _, setter := range setters {
+		setter(cmd)
+	}
+
 	stdout, err := circbuf.NewBuffer(MaxStderrLen)
 	if err != nil {
 		return stdout.String(), err
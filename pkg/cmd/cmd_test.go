@@ -5,7 +5,10 @@
 package cmd_test
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -111,6 +114,26 @@ func (suite *CmdSuite) TestRun() {
 	}
 }
 
+func (suite *CmdSuite) TestRunWithOptions() {
+	out, err := cmd.RunWithOptions(context.Background(), "/bin/sh", []string{"-c", "cat"}, cmd.WithStdin(strings.NewReader("stdin")))
+	suite.Require().NoError(err)
+	suite.Assert().Equal("stdin", out)
+
+	r, w, err := os.Pipe()
+	suite.Require().NoError(err)
+
+	_, err = w.WriteString("extra")
+	suite.Require().NoError(err)
+	suite.Require().NoError(w.Close())
+
+	// nolint: errcheck
+	defer r.Close()
+
+	out, err = cmd.RunWithOptions(context.Background(), "/bin/sh", []string{"-c", "cat <&3"}, cmd.WithExtraFiles(r))
+	suite.Require().NoError(err)
+	suite.Assert().Equal("extra", out)
+}
+
 func TestCmdSuite(t *testing.T) {
 	for _, runReaper := range []bool{true, false} {
 		func(runReaper bool) {
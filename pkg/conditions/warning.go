@@ -0,0 +1,62 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package conditions
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+type warningCondition struct {
+	warningMu  sync.Mutex
+	warning    error
+	warningSet bool
+
+	assertion   AssertionFunc
+	description string
+	timeout     time.Duration
+}
+
+func (w *warningCondition) String() string {
+	warning := "..."
+
+	w.warningMu.Lock()
+	if w.warningSet {
+		if w.warning != nil {
+			warning = fmt.Sprintf("WARNING: %s", w.warning)
+		} else {
+			warning = "OK"
+		}
+	}
+	w.warningMu.Unlock()
+
+	return fmt.Sprintf("%s: %s", w.description, warning)
+}
+
+func (w *warningCondition) Wait(ctx context.Context) error {
+	runCtx, runCtxCancel := context.WithTimeout(ctx, w.timeout)
+	defer runCtxCancel()
+
+	err := w.assertion(runCtx)
+
+	w.warningMu.Lock()
+	w.warning = err
+	w.warningSet = true
+	w.warningMu.Unlock()
+
+	return ctx.Err()
+}
+
+// WarningCondition converts AssertionFunc into Condition which calls it once and
+// reports the assertion failure as a warning instead of failing the wait.
+func WarningCondition(description string, assertion AssertionFunc, timeout time.Duration) Condition {
+	return &warningCondition{
+		assertion:   assertion,
+		description: description,
+		timeout:     timeout,
+	}
+}
@@ -0,0 +1,42 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package conditions_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/talos-systems/talos/pkg/conditions"
+)
+
+func TestWarningCondition(t *testing.T) {
+	ok := conditions.WarningCondition("A", func(ctx context.Context) error {
+		return nil
+	}, time.Second)
+
+	assert.Equal(t, "A: ...", ok.String())
+	assert.NoError(t, ok.Wait(context.Background()))
+	assert.Equal(t, "A: OK", ok.String())
+
+	warning := conditions.WarningCondition("B", func(ctx context.Context) error {
+		return errors.New("failed")
+	}, time.Second)
+
+	assert.NoError(t, warning.Wait(context.Background()))
+	assert.Equal(t, "B: WARNING: failed", warning.String())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	canceled := conditions.WarningCondition("C", func(ctx context.Context) error {
+		return ctx.Err()
+	}, time.Second)
+
+	assert.Equal(t, context.Canceled, canceled.Wait(ctx))
+}
@@ -79,31 +79,28 @@ func NewForConfig(config *restclient.Config) (client *Client, err error) {
 //
 // nolint: interfacer
 func NewClientFromPKI(ca, crt, key []byte, endpoint *url.URL) (client *Client, err error) {
-	tlsClientConfig := restclient.TLSClientConfig{
-		CAData:   ca,
-		CertData: crt,
-		KeyData:  key,
-	}
+	return NewForConfig(clientConfigFromPKI(ca, crt, key, endpoint))
+}
 
-	config := &restclient.Config{
-		Host:            endpoint.String(),
-		TLSClientConfig: tlsClientConfig,
-		Timeout:         30 * time.Second,
+// NewTemporaryClientFromPKI initializes a Kubernetes client using a certificate
+// with a TTL of 10 minutes.
+func NewTemporaryClientFromPKI(ca *x509.PEMEncodedCertificateAndKey, endpoint *url.URL) (client *Client, err error) {
+	config, err := NewTemporaryClientConfigFromPKI(ca, endpoint)
+	if err != nil {
+		return nil, err
 	}
 
-	var clientset *kubernetes.Clientset
-
-	clientset, err = kubernetes.NewForConfig(config)
+	h, err := NewForConfig(config)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to create client: %w", err)
 	}
 
-	return &Client{clientset}, nil
+	return h, nil
 }
 
-// NewTemporaryClientFromPKI initializes a Kubernetes client using a certificate
-// with a TTL of 10 minutes.
-func NewTemporaryClientFromPKI(ca *x509.PEMEncodedCertificateAndKey, endpoint *url.URL) (client *Client, err error) {
+// NewTemporaryClientConfigFromPKI initializes a Kubernetes client config using
+// a certificate with a TTL of 10 minutes.
+func NewTemporaryClientConfigFromPKI(ca *x509.PEMEncodedCertificateAndKey, endpoint *url.URL) (config *restclient.Config, err error) {
 	opts := []x509.Option{
 		x509.RSA(true),
 		x509.CommonName("admin"),
@@ -136,12 +133,21 @@ func NewTemporaryClientFromPKI(ca *x509.PEMEncodedCertificateAndKey, endpoint *u
 		return nil, fmt.Errorf("failed to create certificate from CSR: %w", err)
 	}
 
-	h, err := NewClientFromPKI(ca.Crt, crt.X509CertificatePEM, key.KeyPEM, endpoint)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create client: %w", err)
+	return clientConfigFromPKI(ca.Crt, crt.X509CertificatePEM, key.KeyPEM, endpoint), nil
+}
+
+func clientConfigFromPKI(ca, crt, key []byte, endpoint *url.URL) *restclient.Config {
+	tlsClientConfig := restclient.TLSClientConfig{
+		CAData:   ca,
+		CertData: crt,
+		KeyData:  key,
 	}
 
-	return h, nil
+	return &restclient.Config{
+		Host:            endpoint.String(),
+		TLSClientConfig: tlsClientConfig,
+		Timeout:         30 * time.Second,
+	}
 }
 
 // MasterIPs returns a list of control plane endpoints (IP addresses).
@@ -248,7 +254,12 @@ func (h *Client) LabelNodeAsMaster(name string) (err error) {
 
 // WaitUntilReady waits for a node to be ready.
 func (h *Client) WaitUntilReady(name string) error {
-	return retry.Exponential(3*time.Minute, retry.WithUnits(250*time.Millisecond), retry.WithJitter(50*time.Millisecond)).Retry(func() error {
+	return h.WaitUntilReadyWithTimeout(name, 3*time.Minute)
+}
+
+// WaitUntilReadyWithTimeout waits up to the timeout for a node to be ready.
+func (h *Client) WaitUntilReadyWithTimeout(name string, timeout time.Duration) error {
+	return retry.Exponential(timeout, retry.WithUnits(250*time.Millisecond), retry.WithJitter(50*time.Millisecond)).Retry(func() error {
 		attemptCtx, attemptCtxCancel := context.WithTimeout(context.TODO(), 30*time.Second)
 		defer attemptCtxCancel()
 
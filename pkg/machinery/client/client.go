@@ -298,7 +298,13 @@ func (c *Client) Close() error {
 
 // KubeconfigRaw returns K8s client config (kubeconfig).
 func (c *Client) KubeconfigRaw(ctx context.Context) (io.ReadCloser, <-chan error, error) {
-	stream, err := c.MachineClient.Kubeconfig(ctx, &empty.Empty{})
+	return c.ScopedKubeconfigRaw(ctx, &machineapi.KubeconfigRequest{})
+}
+
+// ScopedKubeconfigRaw returns K8s client config (kubeconfig) for the user,
+// groups and TTL set in the request.
+func (c *Client) ScopedKubeconfigRaw(ctx context.Context, req *machineapi.KubeconfigRequest) (io.ReadCloser, <-chan error, error) {
+	stream, err := c.MachineClient.Kubeconfig(ctx, req)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -344,7 +350,13 @@ func (c *Client) extractKubeconfig(r io.ReadCloser) ([]byte, error) {
 
 // Kubeconfig returns K8s client config (kubeconfig).
 func (c *Client) Kubeconfig(ctx context.Context) ([]byte, error) {
-	r, errCh, err := c.KubeconfigRaw(ctx)
+	return c.ScopedKubeconfig(ctx, &machineapi.KubeconfigRequest{})
+}
+
+// ScopedKubeconfig returns K8s client config (kubeconfig) for the user, groups
+// and TTL set in the request.
+func (c *Client) ScopedKubeconfig(ctx context.Context, req *machineapi.KubeconfigRequest) ([]byte, error) {
+	r, errCh, err := c.ScopedKubeconfigRaw(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -435,9 +447,78 @@ func (c *Client) Rollback(ctx context.Context) (err error) {
 	return
 }
 
+// InterruptedSequence returns the sequence interrupted before the last boot
+// and optionally resumes, rolls back or discards it.
+func (c *Client) InterruptedSequence(ctx context.Context, action machineapi.InterruptedSequenceRequest_Action, callOptions ...grpc.CallOption) (resp *machineapi.InterruptedSequenceResponse, err error) {
+	resp, err = c.MachineClient.InterruptedSequence(
+		ctx,
+		&machineapi.InterruptedSequenceRequest{
+			Action: action,
+		},
+		callOptions...,
+	)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.InterruptedSequenceResponse) //nolint: errcheck
+
+	return
+}
+
+// Sequences returns the running and the queued sequences.
+func (c *Client) Sequences(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.SequencesResponse, err error) {
+	resp, err = c.MachineClient.Sequences(ctx, &empty.Empty{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.SequencesResponse) //nolint: errcheck
+
+	return
+}
+
+// SequenceCancel cancels the running sequence.
+func (c *Client) SequenceCancel(ctx context.Context, sequence string, callOptions ...grpc.CallOption) (resp *machineapi.SequenceCancelResponse, err error) {
+	resp, err = c.MachineClient.SequenceCancel(
+		ctx,
+		&machineapi.SequenceCancelRequest{
+			Sequence: sequence,
+		},
+		callOptions...,
+	)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.SequenceCancelResponse) //nolint: errcheck
+
+	return
+}
+
+// SequenceQueue queues the sequence to run once the running sequence completes.
+func (c *Client) SequenceQueue(ctx context.Context, sequence string, callOptions ...grpc.CallOption) (resp *machineapi.SequenceQueueResponse, err error) {
+	resp, err = c.MachineClient.SequenceQueue(
+		ctx,
+		&machineapi.SequenceQueueRequest{
+			Sequence: sequence,
+		},
+		callOptions...,
+	)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.SequenceQueueResponse) //nolint: errcheck
+
+	return
+}
+
 // Bootstrap implements the proto.OSClient interface.
 func (c *Client) Bootstrap(ctx context.Context) (err error) {
-	_, err = c.MachineClient.Bootstrap(ctx, &machineapi.BootstrapRequest{})
+	return c.BootstrapWithOptions(ctx, &machineapi.BootstrapRequest{})
+}
+
+// BootstrapWithOptions bootstraps the node with the request options, e.g. to
+// recover etcd from the snapshot.
+func (c *Client) BootstrapWithOptions(ctx context.Context, req *machineapi.BootstrapRequest) (err error) {
+	_, err = c.MachineClient.Bootstrap(ctx, req)
 	return
 }
 
@@ -558,6 +639,96 @@ func (c *Client) Mounts(ctx context.Context, callOptions ...grpc.CallOption) (re
 	return
 }
 
+// Disks returns the list of block devices.
+func (c *Client) Disks(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.DisksResponse, err error) {
+	resp, err = c.MachineClient.Disks(
+		ctx,
+		&empty.Empty{},
+		callOptions...,
+	)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.DisksResponse) //nolint: errcheck
+
+	return
+}
+
+// KernelArgs returns the kernel args of the boot label booted by default.
+func (c *Client) KernelArgs(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.KernelArgsResponse, err error) {
+	resp, err = c.MachineClient.KernelArgs(ctx, &empty.Empty{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.KernelArgsResponse) //nolint: errcheck
+
+	return
+}
+
+// KernelArgsUpdate sets and removes the kernel args, the changes take effect
+// on the next boot.
+func (c *Client) KernelArgsUpdate(ctx context.Context, set, remove []string, callOptions ...grpc.CallOption) (resp *machineapi.KernelArgsResponse, err error) {
+	resp, err = c.MachineClient.KernelArgsUpdate(ctx, &machineapi.KernelArgsUpdateRequest{
+		Set:    set,
+		Remove: remove,
+	}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.KernelArgsResponse) //nolint: errcheck
+
+	return
+}
+
+// MetaList returns the keys and values stored on the META partition.
+func (c *Client) MetaList(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.MetaListResponse, err error) {
+	resp, err = c.MachineClient.MetaList(ctx, &empty.Empty{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.MetaListResponse) //nolint: errcheck
+
+	return
+}
+
+// MetaGet returns the value of the key stored on the META partition.
+func (c *Client) MetaGet(ctx context.Context, key string, callOptions ...grpc.CallOption) (resp *machineapi.MetaGetResponse, err error) {
+	resp, err = c.MachineClient.MetaGet(ctx, &machineapi.MetaGetRequest{Key: key}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.MetaGetResponse) //nolint: errcheck
+
+	return
+}
+
+// MetaSet stores the value of the key on the META partition.
+func (c *Client) MetaSet(ctx context.Context, key, value string, callOptions ...grpc.CallOption) (resp *machineapi.MetaSetResponse, err error) {
+	resp, err = c.MachineClient.MetaSet(ctx, &machineapi.MetaSetRequest{
+		Entry: &machineapi.MetaEntry{
+			Key:   key,
+			Value: value,
+		},
+	}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.MetaSetResponse) //nolint: errcheck
+
+	return
+}
+
+// MetaDelete deletes the key stored on the META partition.
+func (c *Client) MetaDelete(ctx context.Context, key string, callOptions ...grpc.CallOption) (resp *machineapi.MetaDeleteResponse, err error) {
+	resp, err = c.MachineClient.MetaDelete(ctx, &machineapi.MetaDeleteRequest{Key: key}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.MetaDeleteResponse) //nolint: errcheck
+
+	return
+}
+
 // LS implements the proto.OSClient interface.
 func (c *Client) LS(ctx context.Context, req *machineapi.ListRequest) (stream machineapi.MachineService_ListClient, err error) {
 	return c.MachineClient.List(ctx, req)
@@ -575,6 +746,171 @@ func (c *Client) Copy(ctx context.Context, rootPath string) (io.ReadCloser, <-ch
 	return ReadStream(stream)
 }
 
+// EtcdSnapshot receives a snapshot of the etcd from the node.
+func (c *Client) EtcdSnapshot(ctx context.Context, callOptions ...grpc.CallOption) (io.ReadCloser, <-chan error, error) {
+	stream, err := c.MachineClient.EtcdSnapshot(ctx, &machineapi.EtcdSnapshotRequest{}, callOptions...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ReadStream(stream)
+}
+
+// CertificateList lists the certificates Talos manages on the nodes.
+func (c *Client) CertificateList(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.CertificateListResponse, err error) {
+	resp, err = c.MachineClient.CertificateList(ctx, &machineapi.CertificateListRequest{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.CertificateListResponse) //nolint: errcheck
+
+	return
+}
+
+// CertificateRotate re-issues the leaf certificates of the nodes restarting
+// the services which use them.
+func (c *Client) CertificateRotate(ctx context.Context, req *machineapi.CertificateRotateRequest, callOptions ...grpc.CallOption) (resp *machineapi.CertificateRotateResponse, err error) {
+	resp, err = c.MachineClient.CertificateRotate(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.CertificateRotateResponse) //nolint: errcheck
+
+	return
+}
+
+// EtcdMemberList lists the members of the etcd cluster.
+func (c *Client) EtcdMemberList(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.EtcdMemberListResponse, err error) {
+	resp, err = c.MachineClient.EtcdMemberList(ctx, &machineapi.EtcdMemberListRequest{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.EtcdMemberListResponse) //nolint: errcheck
+
+	return
+}
+
+// EtcdRemoveMember removes the member of another node from the etcd cluster.
+func (c *Client) EtcdRemoveMember(ctx context.Context, req *machineapi.EtcdRemoveMemberRequest, callOptions ...grpc.CallOption) (resp *machineapi.EtcdRemoveMemberResponse, err error) {
+	resp, err = c.MachineClient.EtcdRemoveMember(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.EtcdRemoveMemberResponse) //nolint: errcheck
+
+	return
+}
+
+// EtcdLeaveCluster makes the node leave the etcd cluster.
+func (c *Client) EtcdLeaveCluster(ctx context.Context, req *machineapi.EtcdLeaveClusterRequest, callOptions ...grpc.CallOption) (resp *machineapi.EtcdLeaveClusterResponse, err error) {
+	resp, err = c.MachineClient.EtcdLeaveCluster(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.EtcdLeaveClusterResponse) //nolint: errcheck
+
+	return
+}
+
+// EtcdForfeitLeadership moves the etcd leadership away from the node.
+func (c *Client) EtcdForfeitLeadership(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.EtcdForfeitLeadershipResponse, err error) {
+	resp, err = c.MachineClient.EtcdForfeitLeadership(ctx, &machineapi.EtcdForfeitLeadershipRequest{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.EtcdForfeitLeadershipResponse) //nolint: errcheck
+
+	return
+}
+
+// EtcdStatus returns the status of the etcd member.
+func (c *Client) EtcdStatus(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.EtcdStatusResponse, err error) {
+	resp, err = c.MachineClient.EtcdStatus(ctx, &machineapi.EtcdStatusRequest{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.EtcdStatusResponse) //nolint: errcheck
+
+	return
+}
+
+// EtcdAlarmList lists the active alarms of the etcd cluster.
+func (c *Client) EtcdAlarmList(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.EtcdAlarmListResponse, err error) {
+	resp, err = c.MachineClient.EtcdAlarmList(ctx, &machineapi.EtcdAlarmListRequest{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.EtcdAlarmListResponse) //nolint: errcheck
+
+	return
+}
+
+// EtcdAlarmDisarm disarms all the alarms of the etcd cluster.
+func (c *Client) EtcdAlarmDisarm(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.EtcdAlarmDisarmResponse, err error) {
+	resp, err = c.MachineClient.EtcdAlarmDisarm(ctx, &machineapi.EtcdAlarmDisarmRequest{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.EtcdAlarmDisarmResponse) //nolint: errcheck
+
+	return
+}
+
+// EtcdDefragment defragments the storage of the etcd member.
+//
+// The member is blocked while it is defragmented, so the nodes should be
+// defragmented one at a time.
+func (c *Client) EtcdDefragment(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.EtcdDefragmentResponse, err error) {
+	resp, err = c.MachineClient.EtcdDefragment(ctx, &machineapi.EtcdDefragmentRequest{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.EtcdDefragmentResponse) //nolint: errcheck
+
+	return
+}
+
+// etcdRecoverChunkSize is the size of the snapshot chunks sent by EtcdRecover.
+const etcdRecoverChunkSize = 1024 * 1024
+
+// EtcdRecover uploads the etcd snapshot to the node, etcd is recovered from the
+// snapshot by the Bootstrap with RecoverEtcd set.
+func (c *Client) EtcdRecover(ctx context.Context, r io.Reader, callOptions ...grpc.CallOption) (*machineapi.EtcdRecoverResponse, error) {
+	stream, err := c.MachineClient.EtcdRecover(ctx, callOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, etcdRecoverChunkSize)
+
+	for {
+		n, readErr := r.Read(buf)
+		if n > 0 {
+			if err = stream.Send(&common.Data{Bytes: buf[:n]}); err != nil {
+				return nil, err
+			}
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+
+		if readErr != nil {
+			stream.CloseSend() //nolint: errcheck
+
+			return nil, readErr
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.EtcdRecoverResponse) //nolint: errcheck
+
+	return resp, err
+}
+
 // Upgrade initiates a Talos upgrade ... and implements the proto.OSClient
 // interface.
 func (c *Client) Upgrade(ctx context.Context, image string, preserve bool, callOptions ...grpc.CallOption) (resp *machineapi.UpgradeResponse, err error) {
@@ -594,6 +930,187 @@ func (c *Client) Upgrade(ctx context.Context, image string, preserve bool, callO
 	return
 }
 
+// imageImportChunkSize is the size of the image archive chunks sent by ImageImport.
+const imageImportChunkSize = 1024 * 1024
+
+// ImageImport streams the installer image archive (OCI or docker tarball) to the
+// nodes, the image is imported as ref.
+func (c *Client) ImageImport(ctx context.Context, ref string, r io.Reader, callOptions ...grpc.CallOption) ([]*machineapi.ImageImport, error) {
+	stream, err := c.MachineClient.ImageImportStream(ctx, callOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, imageImportChunkSize)
+	req := &machineapi.ImageImportRequest{Image: ref}
+
+	for {
+		n, readErr := r.Read(buf)
+		if n > 0 {
+			req.Data = buf[:n]
+
+			if err = stream.Send(req); err != nil {
+				return nil, err
+			}
+
+			req = &machineapi.ImageImportRequest{}
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+
+		if readErr != nil {
+			stream.CloseSend() //nolint: errcheck
+
+			return nil, readErr
+		}
+	}
+
+	// the reference has to reach the node even for an empty archive
+	if req.Image != "" {
+		if err = stream.Send(req); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = stream.CloseSend(); err != nil {
+		return nil, err
+	}
+
+	var resp []*machineapi.ImageImport
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return resp, nil
+			}
+
+			return resp, err
+		}
+
+		resp = append(resp, msg)
+	}
+}
+
+// ImagePull pulls the image into the CRI containerd of the nodes.
+func (c *Client) ImagePull(ctx context.Context, ref string, callOptions ...grpc.CallOption) (resp *machineapi.ImagePullResponse, err error) {
+	resp, err = c.MachineClient.ImagePull(ctx, &machineapi.ImagePullRequest{Reference: ref}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.ImagePullResponse) //nolint: errcheck
+
+	return
+}
+
+// UpgradeFromImport initiates a Talos upgrade with the installer image imported
+// by ImageImport, the image is not pulled from the registry.
+func (c *Client) UpgradeFromImport(ctx context.Context, image string, preserve, stage bool, callOptions ...grpc.CallOption) (resp *machineapi.UpgradeResponse, err error) {
+	resp, err = c.MachineClient.Upgrade(
+		ctx,
+		&machineapi.UpgradeRequest{
+			Image:    image,
+			Preserve: preserve || stage,
+			Stage:    stage,
+			Imported: true,
+		},
+		callOptions...,
+	)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.UpgradeResponse) //nolint: errcheck
+
+	return
+}
+
+// StageUpgrade installs the upgrade image while the node keeps running.
+func (c *Client) StageUpgrade(ctx context.Context, image string, callOptions ...grpc.CallOption) (resp *machineapi.UpgradeResponse, err error) {
+	resp, err = c.MachineClient.Upgrade(
+		ctx,
+		&machineapi.UpgradeRequest{
+			Image:    image,
+			Preserve: true,
+			Stage:    true,
+		},
+		callOptions...,
+	)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.UpgradeResponse) //nolint: errcheck
+
+	return
+}
+
+// CommitUpgrade reboots the node into the staged upgrade.
+func (c *Client) CommitUpgrade(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.UpgradeResponse, err error) {
+	resp, err = c.MachineClient.Upgrade(
+		ctx,
+		&machineapi.UpgradeRequest{
+			Preserve: true,
+			Commit:   true,
+		},
+		callOptions...,
+	)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.UpgradeResponse) //nolint: errcheck
+
+	return
+}
+
+// DiscardUpgrade reverts the staged upgrade, the node keeps booting the
+// running label.
+func (c *Client) DiscardUpgrade(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.UpgradeResponse, err error) {
+	resp, err = c.MachineClient.Upgrade(
+		ctx,
+		&machineapi.UpgradeRequest{
+			Discard: true,
+		},
+		callOptions...,
+	)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.UpgradeResponse) //nolint: errcheck
+
+	return
+}
+
+// UpgradeStatus returns the status of the staged upgrade.
+func (c *Client) UpgradeStatus(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.UpgradeStatusResponse, err error) {
+	resp, err = c.MachineClient.UpgradeStatus(
+		ctx,
+		&empty.Empty{},
+		callOptions...,
+	)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.UpgradeStatusResponse) //nolint: errcheck
+
+	return
+}
+
+// ServiceGraph returns dependency graph of services.
+func (c *Client) ServiceGraph(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.ServiceGraphResponse, err error) {
+	resp, err = c.MachineClient.ServiceGraph(
+		ctx,
+		&empty.Empty{},
+		callOptions...,
+	)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.ServiceGraphResponse) //nolint: errcheck
+
+	return
+}
+
 // ServiceList returns list of services with their state.
 func (c *Client) ServiceList(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.ServiceListResponse, err error) {
 	resp, err = c.MachineClient.ServiceList(
@@ -110,9 +110,11 @@ func (c *Client) EventsWatch(ctx context.Context, watchFunc func(<-chan Event),
 
 		for _, eventType := range []proto.Message{
 			&machineapi.SequenceEvent{},
+			&machineapi.SequenceInterruptedEvent{},
 			&machineapi.PhaseEvent{},
 			&machineapi.TaskEvent{},
 			&machineapi.ServiceStateEvent{},
+			&machineapi.ManifestSyncEvent{},
 		} {
 			if typeURL == "talos/runtime/"+string(eventType.ProtoReflect().Descriptor().FullName()) {
 				msg = eventType
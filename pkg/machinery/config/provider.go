@@ -15,6 +15,7 @@ import (
 	"github.com/talos-systems/crypto/x509"
 
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/disk"
 )
 
 // Provider defines the configuration consumption interface.
@@ -43,6 +44,46 @@ type MachineConfig interface {
 	Kubelet() Kubelet
 	Sysctls() map[string]string
 	Registries() Registries
+	SystemDiskEncryption() SystemDiskEncryption
+}
+
+// SystemDiskEncryption defines the requirements for a config that pertains
+// to the encryption of the system partitions.
+type SystemDiskEncryption interface {
+	Get(label string) Encryption
+}
+
+// Encryption defines the requirements for a config that pertains to the
+// encryption of a partition.
+type Encryption interface {
+	Provider() string
+	Cipher() string
+	KeySize() uint
+	Keys() []EncryptionKey
+}
+
+// EncryptionKey defines the requirements for a config that pertains to the
+// source of a partition encryption key.
+//
+// Exactly one of Static, NodeID and KMS returns non-nil value.
+type EncryptionKey interface {
+	Static() EncryptionKeyStatic
+	NodeID() EncryptionKeyNodeID
+	KMS() EncryptionKeyKMS
+	Slot() int
+}
+
+// EncryptionKeyStatic defines a static encryption key.
+type EncryptionKeyStatic interface {
+	Key() []byte
+}
+
+// EncryptionKeyNodeID defines an encryption key derived from the node secret.
+type EncryptionKeyNodeID interface{}
+
+// EncryptionKeyKMS defines an encryption key fetched from a remote key service.
+type EncryptionKeyKMS interface {
+	Endpoint() string
 }
 
 // Disk represents the options available for partitioning, formatting, and
@@ -50,12 +91,23 @@ type MachineConfig interface {
 type Disk interface {
 	Device() string
 	Partitions() []Partition
+	Wipe() string
+	RAID() RAID
+}
+
+// RAID represents the software RAID array assembled from several disks.
+type RAID interface {
+	Level() string
+	Devices() []string
 }
 
 // Partition represents the options for a device partition.
 type Partition interface {
 	Size() uint
 	MountPoint() string
+	Label() string
+	Filesystem() string
+	MountOptions() []string
 }
 
 // Env represents a set of environment variables.
@@ -73,11 +125,24 @@ type File interface {
 // related options.
 type Install interface {
 	Image() string
+	// Disk is empty if the install disk is looked up with the disk selector.
 	Disk() string
+	// DiskMatchers returns the matchers of the install disk selector, or nil
+	// if the selector is not set.
+	DiskMatchers() ([]disk.Matcher, error)
 	ExtraKernelArgs() []string
 	Zero() bool
 	Force() bool
 	WithBootloader() bool
+	UpgradeHealthGate() UpgradeHealthGate
+}
+
+// UpgradeHealthGate defines the requirements for a config that pertains to
+// the confirmation of the upgrades.
+type UpgradeHealthGate interface {
+	Timeout() time.Duration
+	BootAttempts() int
+	SkipKubelet() bool
 }
 
 // Security defines the requirements for a config that pertains to security
@@ -236,9 +301,32 @@ type ClusterConfig interface {
 	CoreDNS() CoreDNS
 	ExtraManifestURLs() []string
 	ExtraManifestHeaderMap() map[string]string
+	ExtraManifestChecksumMap() map[string]string
+	RequireExtraManifestChecksums() bool
+	InlineManifests() []Manifest
+	ManifestSync() ManifestSync
 	AdminKubeconfig() AdminKubeconfig
 }
 
+// ManifestSync defines the requirements for a config that pertains to the
+// manifests kept applied to the cluster.
+type ManifestSync interface {
+	Enabled() bool
+	Interval() time.Duration
+	Prune() bool
+	Manifests() []Manifest
+}
+
+// Manifest defines the requirements for a config that pertains to the
+// manifest applied to the cluster.
+type Manifest interface {
+	Name() string
+	// URL is empty for the inline manifests.
+	URL() string
+	SHA256() string
+	Contents() string
+}
+
 // ClusterNetwork defines the requirements for a config that pertains to cluster
 // network options.
 type ClusterNetwork interface {
@@ -260,6 +348,26 @@ type CNI interface {
 type APIServer interface {
 	Image() string
 	ExtraArgs() map[string]string
+	// AuditPolicy returns nil if the default audit policy is used.
+	AuditPolicy() map[string]interface{}
+	AuditLog() AuditLog
+	AdmissionControl() []AdmissionPlugin
+}
+
+// AuditLog defines the requirements for a config that pertains to the
+// apiserver audit log.
+type AuditLog interface {
+	Destination() string
+	MaxAge() int
+	MaxBackup() int
+	MaxSize() int
+}
+
+// AdmissionPlugin defines the requirements for a config that pertains to the
+// apiserver admission plugin.
+type AdmissionPlugin interface {
+	Name() string
+	Configuration() map[string]interface{}
 }
 
 // ControllerManager defines the requirements for a config that pertains to controller manager related
@@ -294,6 +402,29 @@ type Etcd interface {
 	Image() string
 	CA() *x509.PEMEncodedCertificateAndKey
 	ExtraArgs() map[string]string
+	Backup() EtcdBackup
+}
+
+// EtcdBackup defines the requirements for a config that pertains to the
+// scheduled etcd backups.
+type EtcdBackup interface {
+	Enabled() bool
+	Interval() time.Duration
+	Retention() int
+	LeaderOnly() bool
+	// S3 returns nil if the backups are not uploaded to the object storage.
+	S3() EtcdBackupS3
+}
+
+// EtcdBackupS3 defines the requirements for a config that pertains to the
+// S3-compatible storage of the etcd backups.
+type EtcdBackupS3 interface {
+	Endpoint() string
+	Bucket() string
+	Region() string
+	Prefix() string
+	AccessKeyID() string
+	SecretAccessKey() string
 }
 
 // Token defines the requirements for a config that pertains to Kubernetes
@@ -24,6 +24,7 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/disk"
 )
 
 const (
@@ -193,6 +194,15 @@ func (m *MachineConfig) Registries() config.Registries {
 	return &m.MachineRegistries
 }
 
+// SystemDiskEncryption implements the config.Provider interface.
+func (m *MachineConfig) SystemDiskEncryption() config.SystemDiskEncryption {
+	if m.MachineSystemDiskEncryption == nil {
+		return &SystemDiskEncryptionConfig{}
+	}
+
+	return m.MachineSystemDiskEncryption
+}
+
 // Image implements the config.Provider interface.
 func (k *KubeletConfig) Image() string {
 	image := k.KubeletImage
@@ -295,6 +305,77 @@ func (a *APIServerConfig) ExtraArgs() map[string]string {
 	return a.ExtraArgsConfig
 }
 
+// AuditPolicy implements the config.Provider interface.
+func (a *APIServerConfig) AuditPolicy() map[string]interface{} {
+	return a.AuditPolicyConfig
+}
+
+// AuditLog implements the config.Provider interface.
+func (a *APIServerConfig) AuditLog() config.AuditLog {
+	if a.AuditLogConfig == nil {
+		return &AuditLogConfig{}
+	}
+
+	return a.AuditLogConfig
+}
+
+// AdmissionControl implements the config.Provider interface.
+func (a *APIServerConfig) AdmissionControl() []config.AdmissionPlugin {
+	plugins := make([]config.AdmissionPlugin, len(a.AdmissionControlConfig))
+
+	for i := range a.AdmissionControlConfig {
+		plugins[i] = a.AdmissionControlConfig[i]
+	}
+
+	return plugins
+}
+
+// Destination implements the config.Provider interface.
+func (l *AuditLogConfig) Destination() string {
+	if l.AuditLogDestination == "" {
+		return constants.AuditLogDestinationStdout
+	}
+
+	return l.AuditLogDestination
+}
+
+// MaxAge implements the config.Provider interface.
+func (l *AuditLogConfig) MaxAge() int {
+	if l.AuditLogMaxAge == 0 {
+		return constants.DefaultAuditLogMaxAge
+	}
+
+	return l.AuditLogMaxAge
+}
+
+// MaxBackup implements the config.Provider interface.
+func (l *AuditLogConfig) MaxBackup() int {
+	if l.AuditLogMaxBackup == 0 {
+		return constants.DefaultAuditLogMaxBackup
+	}
+
+	return l.AuditLogMaxBackup
+}
+
+// MaxSize implements the config.Provider interface.
+func (l *AuditLogConfig) MaxSize() int {
+	if l.AuditLogMaxSize == 0 {
+		return constants.DefaultAuditLogMaxSize
+	}
+
+	return l.AuditLogMaxSize
+}
+
+// Name implements the config.Provider interface.
+func (p *AdmissionPluginConfig) Name() string {
+	return p.PluginName
+}
+
+// Configuration implements the config.Provider interface.
+func (p *AdmissionPluginConfig) Configuration() map[string]interface{} {
+	return p.PluginConfiguration
+}
+
 // ControllerManager implements the config.Provider interface.
 func (c *ClusterConfig) ControllerManager() config.ControllerManager {
 	if c.ControllerManagerConfig == nil {
@@ -419,6 +500,86 @@ func (e *EtcdConfig) ExtraArgs() map[string]string {
 	return e.EtcdExtraArgs
 }
 
+// Backup implements the config.Provider interface.
+func (e *EtcdConfig) Backup() config.EtcdBackup {
+	return e.EtcdBackup
+}
+
+// Enabled implements the config.Provider interface.
+func (b *EtcdBackupConfig) Enabled() bool {
+	return b != nil
+}
+
+// Interval implements the config.Provider interface.
+func (b *EtcdBackupConfig) Interval() time.Duration {
+	if b.BackupInterval == 0 {
+		return constants.DefaultEtcdBackupInterval
+	}
+
+	return b.BackupInterval
+}
+
+// Retention implements the config.Provider interface.
+func (b *EtcdBackupConfig) Retention() int {
+	if b.BackupRetention == 0 {
+		return constants.DefaultEtcdBackupRetention
+	}
+
+	return b.BackupRetention
+}
+
+// LeaderOnly implements the config.Provider interface.
+func (b *EtcdBackupConfig) LeaderOnly() bool {
+	if b.BackupLeaderOnly == nil {
+		return true
+	}
+
+	return *b.BackupLeaderOnly
+}
+
+// S3 implements the config.Provider interface.
+func (b *EtcdBackupConfig) S3() config.EtcdBackupS3 {
+	if b.BackupS3 == nil {
+		return nil
+	}
+
+	return b.BackupS3
+}
+
+// Endpoint implements the config.Provider interface.
+func (s *EtcdBackupS3Config) Endpoint() string {
+	return s.S3Endpoint
+}
+
+// Bucket implements the config.Provider interface.
+func (s *EtcdBackupS3Config) Bucket() string {
+	return s.S3Bucket
+}
+
+// Region implements the config.Provider interface.
+func (s *EtcdBackupS3Config) Region() string {
+	if s.S3Region == "" {
+		return constants.DefaultEtcdBackupS3Region
+	}
+
+	return s.S3Region
+}
+
+// Prefix implements the config.Provider interface.
+func (s *EtcdBackupS3Config) Prefix() string {
+	return s.S3Prefix
+}
+
+// AccessKeyID implements the config.Provider interface.
+func (s *EtcdBackupS3Config) AccessKeyID() string {
+	return s.S3AccessKeyID
+}
+
+// SecretAccessKey implements the config.Provider interface.
+func (s *EtcdBackupS3Config) SecretAccessKey() string {
+	return s.S3SecretAccessKey
+}
+
 // Mirrors implements the Registries interface.
 func (r *RegistriesConfig) Mirrors() map[string]config.RegistryMirrorConfig {
 	mirrors := make(map[string]config.RegistryMirrorConfig, len(r.RegistryMirrors))
@@ -607,6 +768,86 @@ func (c *ClusterConfig) ExtraManifestHeaderMap() map[string]string {
 	return c.ExtraManifestHeaders
 }
 
+// ExtraManifestChecksumMap implements the config.Provider interface.
+func (c *ClusterConfig) ExtraManifestChecksumMap() map[string]string {
+	return c.ExtraManifestChecksums
+}
+
+// RequireExtraManifestChecksums implements the config.Provider interface.
+func (c *ClusterConfig) RequireExtraManifestChecksums() bool {
+	return c.ExtraManifestChecksumsRequired
+}
+
+// InlineManifests implements the config.Provider interface.
+func (c *ClusterConfig) InlineManifests() []config.Manifest {
+	manifests := make([]config.Manifest, len(c.ClusterInlineManifests))
+
+	for i := range c.ClusterInlineManifests {
+		manifests[i] = c.ClusterInlineManifests[i]
+	}
+
+	return manifests
+}
+
+// ManifestSync implements the config.Provider interface.
+func (c *ClusterConfig) ManifestSync() config.ManifestSync {
+	return c.ManifestSyncConfig
+}
+
+// Enabled implements the config.Provider interface.
+func (m *ManifestSyncConfig) Enabled() bool {
+	return m != nil
+}
+
+// Interval implements the config.Provider interface.
+func (m *ManifestSyncConfig) Interval() time.Duration {
+	if m.SyncInterval == 0 {
+		return constants.DefaultManifestSyncInterval
+	}
+
+	return m.SyncInterval
+}
+
+// Prune implements the config.Provider interface.
+func (m *ManifestSyncConfig) Prune() bool {
+	if m.SyncPrune == nil {
+		return true
+	}
+
+	return *m.SyncPrune
+}
+
+// Manifests implements the config.Provider interface.
+func (m *ManifestSyncConfig) Manifests() []config.Manifest {
+	manifests := make([]config.Manifest, len(m.SyncManifests))
+
+	for i := range m.SyncManifests {
+		manifests[i] = m.SyncManifests[i]
+	}
+
+	return manifests
+}
+
+// Name implements the config.Provider interface.
+func (m *ManifestConfig) Name() string {
+	return m.ManifestName
+}
+
+// URL implements the config.Provider interface.
+func (m *ManifestConfig) URL() string {
+	return m.ManifestURL
+}
+
+// SHA256 implements the config.Provider interface.
+func (m *ManifestConfig) SHA256() string {
+	return m.ManifestSHA256
+}
+
+// Contents implements the config.Provider interface.
+func (m *ManifestConfig) Contents() string {
+	return m.ManifestContents
+}
+
 // PodCheckpointer implements the config.Provider interface.
 func (c *ClusterConfig) PodCheckpointer() config.PodCheckpointer {
 	if c.PodCheckpointerConfig == nil {
@@ -938,10 +1179,81 @@ func (i *InstallConfig) Image() string {
 }
 
 // Disk implements the config.Provider interface.
+//
 func (i *InstallConfig) Disk() string {
 	return i.InstallDisk
 }
 
+// DiskMatchers implements the config.Provider interface.
+func (i *InstallConfig) DiskMatchers() ([]disk.Matcher, error) {
+	if i.InstallDiskSelector == nil {
+		return nil, nil
+	}
+
+	return i.InstallDiskSelector.Matchers()
+}
+
+// Matchers returns the disk matchers for the attributes set in the selector.
+func (s *InstallDiskSelector) Matchers() ([]disk.Matcher, error) {
+	var matchers []disk.Matcher
+
+	if s.Size != nil {
+		var (
+			min, max uint64
+			err      error
+		)
+
+		if s.Size.Min != "" {
+			if min, err = disk.ParseSize(s.Size.Min); err != nil {
+				return nil, fmt.Errorf("invalid minimum disk size: %w", err)
+			}
+		}
+
+		if s.Size.Max != "" {
+			if max, err = disk.ParseSize(s.Size.Max); err != nil {
+				return nil, fmt.Errorf("invalid maximum disk size: %w", err)
+			}
+
+			if max < min {
+				return nil, fmt.Errorf("maximum disk size %q is less than minimum disk size %q", s.Size.Max, s.Size.Min)
+			}
+		}
+
+		matchers = append(matchers, disk.WithSize(min, max))
+	}
+
+	if s.Model != "" {
+		matchers = append(matchers, disk.WithModel(s.Model))
+	}
+
+	if s.Serial != "" {
+		matchers = append(matchers, disk.WithSerial(s.Serial))
+	}
+
+	if s.WWN != "" {
+		matchers = append(matchers, disk.WithWWN(s.WWN))
+	}
+
+	if s.Type != "" {
+		t, err := disk.ParseType(s.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		matchers = append(matchers, disk.WithType(t))
+	}
+
+	if s.BusPath != "" {
+		matchers = append(matchers, disk.WithBusPath(s.BusPath))
+	}
+
+	if len(matchers) == 0 {
+		return nil, fmt.Errorf("install disk selector should specify at least one attribute")
+	}
+
+	return matchers, nil
+}
+
 // ExtraKernelArgs implements the config.Provider interface.
 func (i *InstallConfig) ExtraKernelArgs() []string {
 	return i.InstallExtraKernelArgs
@@ -962,6 +1274,38 @@ func (i *InstallConfig) WithBootloader() bool {
 	return i.InstallBootloader
 }
 
+// UpgradeHealthGate implements the config.Provider interface.
+func (i *InstallConfig) UpgradeHealthGate() config.UpgradeHealthGate {
+	if i.InstallUpgradeHealthGate == nil {
+		return &UpgradeHealthGateConfig{}
+	}
+
+	return i.InstallUpgradeHealthGate
+}
+
+// Timeout implements the config.Provider interface.
+func (u *UpgradeHealthGateConfig) Timeout() time.Duration {
+	if u.HealthGateTimeout == 0 {
+		return constants.DefaultUpgradeHealthGateTimeout
+	}
+
+	return u.HealthGateTimeout
+}
+
+// BootAttempts implements the config.Provider interface.
+func (u *UpgradeHealthGateConfig) BootAttempts() int {
+	if u.HealthGateBootAttempts == 0 {
+		return constants.DefaultUpgradeBootAttempts
+	}
+
+	return u.HealthGateBootAttempts
+}
+
+// SkipKubelet implements the config.Provider interface.
+func (u *UpgradeHealthGateConfig) SkipKubelet() bool {
+	return u.HealthGateSkipKubelet
+}
+
 // Image implements the config.Provider interface.
 func (c *CoreDNS) Image() string {
 	coreDNSImage := asset.DefaultImages.CoreDNS
@@ -1034,6 +1378,34 @@ func (d *MachineDisk) Partitions() []config.Partition {
 	return partitions
 }
 
+// Wipe implements the config.Provider interface.
+func (d *MachineDisk) Wipe() string {
+	if d.DiskWipe == "" {
+		return constants.DiskWipeNever
+	}
+
+	return d.DiskWipe
+}
+
+// RAID implements the config.Provider interface.
+func (d *MachineDisk) RAID() config.RAID {
+	if d.DiskRAID == nil {
+		return nil
+	}
+
+	return d.DiskRAID
+}
+
+// Level implements the config.Provider interface.
+func (r *DiskRAID) Level() string {
+	return r.RAIDLevel
+}
+
+// Devices implements the config.Provider interface.
+func (r *DiskRAID) Devices() []string {
+	return r.RAIDDevices
+}
+
 // Size implements the config.Provider interface.
 func (p *DiskPartition) Size() uint {
 	return p.DiskSize
@@ -1043,3 +1415,110 @@ func (p *DiskPartition) Size() uint {
 func (p *DiskPartition) MountPoint() string {
 	return p.DiskMountPoint
 }
+
+// Label implements the config.Provider interface.
+func (p *DiskPartition) Label() string {
+	return p.DiskLabel
+}
+
+// Filesystem implements the config.Provider interface.
+func (p *DiskPartition) Filesystem() string {
+	if p.DiskFilesystem == "" {
+		return constants.DefaultUserDiskFilesystem
+	}
+
+	return p.DiskFilesystem
+}
+
+// MountOptions implements the config.Provider interface.
+func (p *DiskPartition) MountOptions() []string {
+	if len(p.DiskMountOptions) == 0 {
+		return []string{"noatime"}
+	}
+
+	return p.DiskMountOptions
+}
+
+// Get implements the config.Provider interface.
+func (e *SystemDiskEncryptionConfig) Get(label string) config.Encryption {
+	switch label {
+	case constants.StatePartitionLabel:
+		if e.StatePartition != nil {
+			return e.StatePartition
+		}
+	case constants.EphemeralPartitionLabel:
+		if e.EphemeralPartition != nil {
+			return e.EphemeralPartition
+		}
+	}
+
+	return nil
+}
+
+// Provider implements the config.Provider interface.
+func (e *EncryptionConfig) Provider() string {
+	return e.EncryptionProvider
+}
+
+// Cipher implements the config.Provider interface.
+func (e *EncryptionConfig) Cipher() string {
+	return e.EncryptionCipher
+}
+
+// KeySize implements the config.Provider interface.
+func (e *EncryptionConfig) KeySize() uint {
+	return e.EncryptionKeySize
+}
+
+// Keys implements the config.Provider interface.
+func (e *EncryptionConfig) Keys() []config.EncryptionKey {
+	keys := make([]config.EncryptionKey, len(e.EncryptionKeys))
+
+	for i := range e.EncryptionKeys {
+		keys[i] = e.EncryptionKeys[i]
+	}
+
+	return keys
+}
+
+// Static implements the config.Provider interface.
+func (k *EncryptionKey) Static() config.EncryptionKeyStatic {
+	if k.KeyStatic == nil {
+		return nil
+	}
+
+	return k.KeyStatic
+}
+
+// NodeID implements the config.Provider interface.
+func (k *EncryptionKey) NodeID() config.EncryptionKeyNodeID {
+	if k.KeyNodeID == nil {
+		return nil
+	}
+
+	return k.KeyNodeID
+}
+
+// KMS implements the config.Provider interface.
+func (k *EncryptionKey) KMS() config.EncryptionKeyKMS {
+	if k.KeyKMS == nil {
+		return nil
+	}
+
+	return k.KeyKMS
+}
+
+// Slot implements the config.Provider interface.
+func (k *EncryptionKey) Slot() int {
+	return k.KeySlot
+}
+
+// Key implements the config.Provider interface.
+func (k *EncryptionKeyStatic) Key() []byte {
+	return []byte(k.KeyData)
+}
+
+// Endpoint implements the config.Provider interface.
+func (k *EncryptionKeyKMS) Endpoint() string {
+	return k.KMSEndpoint
+}
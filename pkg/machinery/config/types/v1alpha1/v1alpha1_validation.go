@@ -7,11 +7,17 @@
 package v1alpha1
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
 	"os"
+	"path"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
 
@@ -23,6 +29,19 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
+var (
+	mdDeviceRegexp = regexp.MustCompile(`^/dev/md[0-9]+$`)
+
+	systemPartitionLabels = map[string]struct{}{
+		constants.EFIPartitionLabel:       {},
+		constants.BIOSGrubPartitionLabel:  {},
+		constants.BootPartitionLabel:      {},
+		constants.MetaPartitionLabel:      {},
+		constants.StatePartitionLabel:     {},
+		constants.EphemeralPartitionLabel: {},
+	}
+)
+
 var (
 	// General
 
@@ -76,12 +95,33 @@ func (c *Config) Validate(mode config.RuntimeMode) error {
 			result = multierror.Append(result, fmt.Errorf("install instructions are required in %q mode", mode))
 		}
 
-		if c.MachineConfig.MachineInstall.InstallDisk == "" {
-			result = multierror.Append(result, fmt.Errorf("an install disk is required in %q mode", mode))
+		switch {
+		case c.MachineConfig.MachineInstall.InstallDiskSelector != nil:
+			if c.MachineConfig.MachineInstall.InstallDisk != "" {
+				result = multierror.Append(result, errors.New("install disk and install disk selector are mutually exclusive"))
+			}
+
+			if _, err := c.MachineConfig.MachineInstall.InstallDiskSelector.Matchers(); err != nil {
+				result = multierror.Append(result, err)
+			}
+		case c.MachineConfig.MachineInstall.InstallDisk == "":
+			result = multierror.Append(result, fmt.Errorf("an install disk or an install disk selector is required in %q mode", mode))
+		default:
+			if _, err := os.Stat(c.MachineConfig.MachineInstall.InstallDisk); os.IsNotExist(err) {
+				result = multierror.Append(result, fmt.Errorf("specified install disk does not exist: %q", c.MachineConfig.MachineInstall.InstallDisk))
+			}
+		}
+	}
+
+	if c.MachineConfig.MachineInstall != nil && c.MachineConfig.MachineInstall.InstallUpgradeHealthGate != nil {
+		gate := c.MachineConfig.MachineInstall.InstallUpgradeHealthGate
+
+		if gate.HealthGateTimeout < 0 {
+			result = multierror.Append(result, fmt.Errorf("upgrade health gate timeout should be positive: %s", gate.HealthGateTimeout))
 		}
 
-		if _, err := os.Stat(c.MachineConfig.MachineInstall.InstallDisk); os.IsNotExist(err) {
-			result = multierror.Append(result, fmt.Errorf("specified install disk does not exist: %q", c.MachineConfig.MachineInstall.InstallDisk))
+		if gate.HealthGateBootAttempts < 0 {
+			result = multierror.Append(result, fmt.Errorf("upgrade boot attempts should be positive: %d", gate.HealthGateBootAttempts))
 		}
 	}
 
@@ -108,10 +148,23 @@ func (c *Config) Validate(mode config.RuntimeMode) error {
 
 	if c.MachineConfig.MachineDisks != nil {
 		for _, disk := range c.MachineConfig.MachineDisks {
-			for i, pt := range disk.DiskPartitions {
-				if pt.DiskSize == 0 && i != len(disk.DiskPartitions)-1 {
-					result = multierror.Append(result, fmt.Errorf("partition for disk %q is set to occupy full disk, but it's not the last partition in the list", disk.Device()))
-				}
+			if err := disk.Validate(); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	if c.MachineConfig.MachineSystemDiskEncryption != nil {
+		for label, encryption := range map[string]*EncryptionConfig{
+			constants.StatePartitionLabel:     c.MachineConfig.MachineSystemDiskEncryption.StatePartition,
+			constants.EphemeralPartitionLabel: c.MachineConfig.MachineSystemDiskEncryption.EphemeralPartition,
+		} {
+			if encryption == nil {
+				continue
+			}
+
+			if err := encryption.Validate(label); err != nil {
+				result = multierror.Append(result, err)
 			}
 		}
 	}
@@ -139,6 +192,382 @@ func (c *ClusterConfig) Validate() error {
 		result = multierror.Append(result, fmt.Errorf("invalid controlplane endpoint: %w", err))
 	}
 
+	if c.APIServerConfig != nil {
+		if err := c.APIServerConfig.Validate(); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if c.EtcdConfig != nil && c.EtcdConfig.EtcdBackup != nil {
+		if err := c.EtcdConfig.EtcdBackup.Validate(); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if c.ManifestSyncConfig != nil {
+		if err := c.ManifestSyncConfig.Validate(); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if err := c.validateExtraManifests(); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	return result.ErrorOrNil()
+}
+
+// validateExtraManifests validates the checksums of the extra manifests and
+// the inline manifests.
+//
+//nolint: gocyclo
+func (c *ClusterConfig) validateExtraManifests() error {
+	var result *multierror.Error
+
+	urls := append([]string(nil), c.ExtraManifests...)
+
+	if c.CNI().Name() == constants.CustomCNI {
+		urls = append(urls, c.CNI().URLs()...)
+	}
+
+	known := make(map[string]struct{}, len(urls))
+
+	for _, u := range urls {
+		known[u] = struct{}{}
+
+		if _, ok := c.ExtraManifestChecksums[u]; !ok && c.ExtraManifestChecksumsRequired {
+			result = multierror.Append(result, fmt.Errorf("extra manifest %q should have the SHA-256 checksum", u))
+		}
+	}
+
+	for u, sum := range c.ExtraManifestChecksums {
+		if _, ok := known[u]; !ok {
+			result = multierror.Append(result, fmt.Errorf("checksum is set for the unknown extra manifest %q", u))
+		}
+
+		if checksum, err := hex.DecodeString(sum); err != nil || len(checksum) != sha256.Size {
+			result = multierror.Append(result, fmt.Errorf("extra manifest %q checksum should be the SHA-256 checksum in hex: %q", u, sum))
+		}
+
+		// the manifests with the checksum are fetched and verified as the
+		// manifests kept applied by the manifest sync
+		if parsed, err := url.Parse(u); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+			result = multierror.Append(result, fmt.Errorf("extra manifest %q with the checksum should be an http(s) URL", u))
+		}
+	}
+
+	// the fetched manifests are stored under the base names of the URLs
+	fetched := make(map[string]string, len(urls))
+
+	for _, u := range urls {
+		fetched[path.Base(u)] = u
+	}
+
+	names := map[string]struct{}{}
+
+	for _, manifest := range c.ClusterInlineManifests {
+		// the name is used as the file name in the bootkube assets
+		if manifest.ManifestName == "" || manifest.ManifestName == "." || manifest.ManifestName == ".." || strings.ContainsRune(manifest.ManifestName, '/') {
+			result = multierror.Append(result, fmt.Errorf("inline manifest name should be a valid file name: %q", manifest.ManifestName))
+
+			continue
+		}
+
+		if _, ok := names[manifest.ManifestName]; ok {
+			result = multierror.Append(result, fmt.Errorf("inline manifest %q is duplicated", manifest.ManifestName))
+		}
+
+		names[manifest.ManifestName] = struct{}{}
+
+		if u, ok := fetched[manifest.ManifestName+".yaml"]; ok {
+			result = multierror.Append(result, fmt.Errorf("inline manifest %q collides with the extra manifest %q", manifest.ManifestName, u))
+		}
+
+		if manifest.ManifestURL != "" || manifest.ManifestSHA256 != "" {
+			result = multierror.Append(result, fmt.Errorf("inline manifest %q can't have the URL or the checksum", manifest.ManifestName))
+		}
+
+		if strings.TrimSpace(manifest.ManifestContents) == "" {
+			result = multierror.Append(result, fmt.Errorf("inline manifest %q contents are empty", manifest.ManifestName))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate validates the manifest sync config.
+func (m *ManifestSyncConfig) Validate() error {
+	var result *multierror.Error
+
+	if m.SyncInterval < 0 {
+		result = multierror.Append(result, fmt.Errorf("manifest sync interval should be positive: %s", m.SyncInterval))
+	}
+
+	names := map[string]struct{}{}
+
+	for _, manifest := range m.SyncManifests {
+		if manifest.ManifestName == "" {
+			result = multierror.Append(result, errors.New("manifest name is required"))
+
+			continue
+		}
+
+		if _, ok := names[manifest.ManifestName]; ok {
+			result = multierror.Append(result, fmt.Errorf("manifest %q is duplicated", manifest.ManifestName))
+		}
+
+		names[manifest.ManifestName] = struct{}{}
+
+		if (manifest.ManifestURL == "") == (manifest.ManifestContents == "") {
+			result = multierror.Append(result, fmt.Errorf("manifest %q should have either the URL or the contents", manifest.ManifestName))
+
+			continue
+		}
+
+		if manifest.ManifestURL != "" {
+			if u, err := url.Parse(manifest.ManifestURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				result = multierror.Append(result, fmt.Errorf("manifest %q URL should be an http(s) URL: %q", manifest.ManifestName, manifest.ManifestURL))
+			}
+
+			// the fetched manifests are pinned, so that the sync is reproducible
+			if checksum, err := hex.DecodeString(manifest.ManifestSHA256); err != nil || len(checksum) != sha256.Size {
+				result = multierror.Append(result, fmt.Errorf("manifest %q should have the SHA-256 checksum of the URL contents", manifest.ManifestName))
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate validates the API server config.
+//
+// The args generated from the audit and the admission config can't be
+// overridden with the extra args.
+//
+//nolint: gocyclo
+func (a *APIServerConfig) Validate() error {
+	var result *multierror.Error
+
+	checkExtraArgs := func(section string, args ...string) {
+		for _, arg := range args {
+			if _, ok := a.ExtraArgsConfig[arg]; ok {
+				result = multierror.Append(result, fmt.Errorf("apiserver extra arg %q can't be used along with the %s config", arg, section))
+			}
+		}
+	}
+
+	if a.AuditPolicyConfig != nil {
+		apiVersion, _ := a.AuditPolicyConfig["apiVersion"].(string) //nolint: errcheck
+		kind, _ := a.AuditPolicyConfig["kind"].(string)             //nolint: errcheck
+
+		if !strings.HasPrefix(apiVersion, "audit.k8s.io/") || kind != "Policy" {
+			result = multierror.Append(result, fmt.Errorf("apiserver audit policy should be of kind Policy in the audit.k8s.io group, got %q %q", apiVersion, kind))
+		}
+
+		checkExtraArgs("audit policy", "audit-policy-file")
+	}
+
+	if l := a.AuditLogConfig; l != nil {
+		if d := l.AuditLogDestination; d != "" && d != constants.AuditLogDestinationStdout && d != constants.AuditLogDestinationFile {
+			result = multierror.Append(result, fmt.Errorf("apiserver audit log destination should be one of [%s,%s]: %q",
+				constants.AuditLogDestinationStdout, constants.AuditLogDestinationFile, l.AuditLogDestination))
+		}
+
+		if l.AuditLogMaxAge < 0 || l.AuditLogMaxBackup < 0 || l.AuditLogMaxSize < 0 {
+			result = multierror.Append(result, errors.New("apiserver audit log rotation settings should be positive"))
+		}
+
+		if l.AuditLogDestination == constants.AuditLogDestinationFile {
+			checkExtraArgs("audit log", "audit-log-path", "audit-log-maxage", "audit-log-maxbackup", "audit-log-maxsize")
+		}
+	}
+
+	if len(a.AdmissionControlConfig) > 0 {
+		names := map[string]struct{}{}
+
+		for _, plugin := range a.AdmissionControlConfig {
+			if plugin.PluginName == "" {
+				result = multierror.Append(result, errors.New("apiserver admission plugin name is required"))
+
+				continue
+			}
+
+			if _, ok := names[plugin.PluginName]; ok {
+				result = multierror.Append(result, fmt.Errorf("apiserver admission plugin %q is duplicated", plugin.PluginName))
+			}
+
+			names[plugin.PluginName] = struct{}{}
+		}
+
+		checkExtraArgs("admission control", "admission-control-config-file")
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate validates the etcd backup config.
+func (b *EtcdBackupConfig) Validate() error {
+	var result *multierror.Error
+
+	if b.BackupInterval < 0 {
+		result = multierror.Append(result, fmt.Errorf("etcd backup interval should be positive: %s", b.BackupInterval))
+	}
+
+	if b.BackupRetention < 0 {
+		result = multierror.Append(result, fmt.Errorf("etcd backup retention should be positive: %d", b.BackupRetention))
+	}
+
+	if s3 := b.BackupS3; s3 != nil {
+		if u, err := url.Parse(s3.S3Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			result = multierror.Append(result, fmt.Errorf("etcd backup S3 endpoint should be an http(s) URL: %q", s3.S3Endpoint))
+		}
+
+		if s3.S3Bucket == "" {
+			result = multierror.Append(result, errors.New("etcd backup S3 bucket is required"))
+		}
+
+		if s3.S3AccessKeyID == "" || s3.S3SecretAccessKey == "" {
+			result = multierror.Append(result, errors.New("etcd backup S3 access key ID and secret access key are required"))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate validates the user disk config.
+//
+//nolint: gocyclo
+func (d *MachineDisk) Validate() error {
+	var result *multierror.Error
+
+	switch d.Wipe() {
+	case constants.DiskWipeNever, constants.DiskWipeMismatch, constants.DiskWipeAlways:
+	default:
+		result = multierror.Append(result, fmt.Errorf("disk %q: unsupported wipe policy %q, supported policies: never, mismatch, always", d.DeviceName, d.DiskWipe))
+	}
+
+	if d.DiskRAID != nil {
+		if !mdDeviceRegexp.MatchString(d.DeviceName) {
+			result = multierror.Append(result, fmt.Errorf("disk %q: RAID array device should be named /dev/mdN", d.DeviceName))
+		}
+
+		switch d.DiskRAID.RAIDLevel {
+		case "raid0", "raid1":
+		default:
+			result = multierror.Append(result, fmt.Errorf("disk %q: unsupported RAID level %q, supported levels: raid0, raid1", d.DeviceName, d.DiskRAID.RAIDLevel))
+		}
+
+		if len(d.DiskRAID.RAIDDevices) < 2 {
+			result = multierror.Append(result, fmt.Errorf("disk %q: RAID array requires at least two devices", d.DeviceName))
+		}
+	}
+
+	labels := map[string]struct{}{}
+
+	for i, pt := range d.DiskPartitions {
+		if pt.DiskSize == 0 && i != len(d.DiskPartitions)-1 {
+			result = multierror.Append(result, fmt.Errorf("partition for disk %q is set to occupy full disk, but it's not the last partition in the list", d.DeviceName))
+		}
+
+		maxLabelLength := 0
+
+		switch pt.Filesystem() {
+		case "xfs":
+			maxLabelLength = 12
+		case "ext4":
+			maxLabelLength = 16
+		case "vfat":
+			maxLabelLength = 11
+		default:
+			result = multierror.Append(result, fmt.Errorf("partition %d for disk %q: unsupported filesystem %q, supported filesystems: xfs, ext4, vfat", i+1, d.DeviceName, pt.DiskFilesystem))
+		}
+
+		if pt.DiskLabel == "" {
+			continue
+		}
+
+		if maxLabelLength > 0 && len(pt.DiskLabel) > maxLabelLength {
+			result = multierror.Append(result, fmt.Errorf("partition %d for disk %q: label %q is longer than %d characters", i+1, d.DeviceName, pt.DiskLabel, maxLabelLength))
+		}
+
+		if _, ok := systemPartitionLabels[pt.DiskLabel]; ok {
+			result = multierror.Append(result, fmt.Errorf("partition %d for disk %q: label %q is reserved for the system partitions", i+1, d.DeviceName, pt.DiskLabel))
+		}
+
+		if _, ok := labels[pt.DiskLabel]; ok {
+			result = multierror.Append(result, fmt.Errorf("partition %d for disk %q: duplicate label %q", i+1, d.DeviceName, pt.DiskLabel))
+		}
+
+		labels[pt.DiskLabel] = struct{}{}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate validates the partition encryption config.
+//
+//nolint: gocyclo
+func (e *EncryptionConfig) Validate(label string) error {
+	var result *multierror.Error
+
+	if e.EncryptionProvider != "luks2" {
+		result = multierror.Append(result, fmt.Errorf("%s partition: unsupported encryption provider %q, supported providers: luks2", label, e.EncryptionProvider))
+	}
+
+	if len(e.EncryptionKeys) == 0 {
+		result = multierror.Append(result, fmt.Errorf("%s partition: at least one encryption key is required", label))
+	}
+
+	slots := map[int]struct{}{}
+	unlockedWithoutConfig := false
+
+	for _, key := range e.EncryptionKeys {
+		sources := 0
+
+		if key.KeyStatic != nil {
+			sources++
+
+			if key.KeyStatic.KeyData == "" {
+				result = multierror.Append(result, fmt.Errorf("%s partition: static key passphrase is empty", label))
+			}
+		}
+
+		if key.KeyNodeID != nil {
+			sources++
+
+			unlockedWithoutConfig = true
+		}
+
+		if key.KeyKMS != nil {
+			sources++
+
+			unlockedWithoutConfig = true
+
+			if u, err := url.Parse(key.KeyKMS.KMSEndpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				result = multierror.Append(result, fmt.Errorf("%s partition: invalid key service endpoint %q", label, key.KeyKMS.KMSEndpoint))
+			}
+		}
+
+		if sources != 1 {
+			result = multierror.Append(result, fmt.Errorf("%s partition: key in slot %d should have exactly one of static, nodeID or kms set", label, key.KeySlot))
+		}
+
+		if key.KeySlot < 0 || key.KeySlot > 31 {
+			result = multierror.Append(result, fmt.Errorf("%s partition: key slot %d is out of range [0, 31]", label, key.KeySlot))
+		}
+
+		if _, ok := slots[key.KeySlot]; ok {
+			result = multierror.Append(result, fmt.Errorf("%s partition: key slot %d is used more than once", label, key.KeySlot))
+		}
+
+		slots[key.KeySlot] = struct{}{}
+	}
+
+	// STATE is unlocked before the config is loaded, so static keys can't be used
+	if label == constants.StatePartitionLabel && len(e.EncryptionKeys) > 0 && !unlockedWithoutConfig {
+		result = multierror.Append(result, fmt.Errorf("%s partition: at least one nodeID or kms key is required", label))
+	}
+
 	return result.ErrorOrNil()
 }
 
@@ -0,0 +1,490 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+func TestMachineDiskValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		disk          *v1alpha1.MachineDisk
+		expectedError string
+	}{
+		{
+			name: "defaults",
+			disk: &v1alpha1.MachineDisk{
+				DeviceName: "/dev/sdb",
+				DiskPartitions: []*v1alpha1.DiskPartition{
+					{
+						DiskMountPoint: "/var/lib/extra",
+					},
+				},
+			},
+		},
+		{
+			name: "raid",
+			disk: &v1alpha1.MachineDisk{
+				DeviceName: "/dev/md0",
+				DiskWipe:   "mismatch",
+				DiskRAID: &v1alpha1.DiskRAID{
+					RAIDLevel:   "raid1",
+					RAIDDevices: []string{"/dev/sdb", "/dev/sdc"},
+				},
+				DiskPartitions: []*v1alpha1.DiskPartition{
+					{
+						DiskSize:         1024 * 1024 * 1024,
+						DiskMountPoint:   "/var/lib/extra",
+						DiskLabel:        "extra",
+						DiskFilesystem:   "ext4",
+						DiskMountOptions: []string{"noatime", "discard"},
+					},
+					{
+						DiskMountPoint: "/var/lib/data",
+						DiskLabel:      "data",
+					},
+				},
+			},
+		},
+		{
+			name: "invalid",
+			disk: &v1alpha1.MachineDisk{
+				DeviceName: "/dev/sdb",
+				DiskWipe:   "sometimes",
+				DiskRAID: &v1alpha1.DiskRAID{
+					RAIDLevel:   "raid5",
+					RAIDDevices: []string{"/dev/sdc"},
+				},
+				DiskPartitions: []*v1alpha1.DiskPartition{
+					{
+						DiskMountPoint: "/var/lib/extra",
+						DiskLabel:      "EPHEMERAL",
+					},
+					{
+						DiskMountPoint: "/var/lib/data",
+						DiskLabel:      "data-partition",
+						DiskFilesystem: "btrfs",
+					},
+					{
+						DiskMountPoint: "/var/lib/boot",
+						DiskLabel:      "efi-partition",
+						DiskFilesystem: "vfat",
+					},
+				},
+			},
+			expectedError: "9 errors occurred:\n" +
+				"\t* disk \"/dev/sdb\": unsupported wipe policy \"sometimes\", supported policies: never, mismatch, always\n" +
+				"\t* disk \"/dev/sdb\": RAID array device should be named /dev/mdN\n" +
+				"\t* disk \"/dev/sdb\": unsupported RAID level \"raid5\", supported levels: raid0, raid1\n" +
+				"\t* disk \"/dev/sdb\": RAID array requires at least two devices\n" +
+				"\t* partition for disk \"/dev/sdb\" is set to occupy full disk, but it's not the last partition in the list\n" +
+				"\t* partition 1 for disk \"/dev/sdb\": label \"EPHEMERAL\" is reserved for the system partitions\n" +
+				"\t* partition for disk \"/dev/sdb\" is set to occupy full disk, but it's not the last partition in the list\n" +
+				"\t* partition 2 for disk \"/dev/sdb\": unsupported filesystem \"btrfs\", supported filesystems: xfs, ext4, vfat\n" +
+				"\t* partition 3 for disk \"/dev/sdb\": label \"efi-partition\" is longer than 11 characters\n" +
+				"\n",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.disk.Validate()
+
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestEtcdBackupValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		backup        *v1alpha1.EtcdBackupConfig
+		expectedError string
+	}{
+		{
+			name:   "defaults",
+			backup: &v1alpha1.EtcdBackupConfig{},
+		},
+		{
+			name: "s3",
+			backup: &v1alpha1.EtcdBackupConfig{
+				BackupInterval:  30 * time.Minute,
+				BackupRetention: 10,
+				BackupS3: &v1alpha1.EtcdBackupS3Config{
+					S3Endpoint:        "http://127.0.0.1:9000",
+					S3Bucket:          "etcd",
+					S3AccessKeyID:     "minio",
+					S3SecretAccessKey: "minio123",
+				},
+			},
+		},
+		{
+			name: "invalid",
+			backup: &v1alpha1.EtcdBackupConfig{
+				BackupInterval:  -time.Minute,
+				BackupRetention: -1,
+				BackupS3: &v1alpha1.EtcdBackupS3Config{
+					S3Endpoint: "s3.amazonaws.com",
+				},
+			},
+			expectedError: "5 errors occurred:\n" +
+				"\t* etcd backup interval should be positive: -1m0s\n" +
+				"\t* etcd backup retention should be positive: -1\n" +
+				"\t* etcd backup S3 endpoint should be an http(s) URL: \"s3.amazonaws.com\"\n" +
+				"\t* etcd backup S3 bucket is required\n" +
+				"\t* etcd backup S3 access key ID and secret access key are required\n" +
+				"\n",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.backup.Validate()
+
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestAPIServerValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		apiServer     *v1alpha1.APIServerConfig
+		expectedError string
+	}{
+		{
+			name:      "defaults",
+			apiServer: &v1alpha1.APIServerConfig{},
+		},
+		{
+			name: "audit and admission",
+			apiServer: &v1alpha1.APIServerConfig{
+				ExtraArgsConfig: map[string]string{
+					"audit-log-maxage": "7",
+				},
+				AuditPolicyConfig: map[string]interface{}{
+					"apiVersion": "audit.k8s.io/v1",
+					"kind":       "Policy",
+					"rules": []interface{}{
+						map[string]interface{}{
+							"level": "Metadata",
+						},
+					},
+				},
+				AuditLogConfig: &v1alpha1.AuditLogConfig{
+					AuditLogDestination: "stdout",
+				},
+				AdmissionControlConfig: []*v1alpha1.AdmissionPluginConfig{
+					{
+						PluginName: "EventRateLimit",
+						PluginConfiguration: map[string]interface{}{
+							"apiVersion": "eventratelimit.admission.k8s.io/v1alpha1",
+							"kind":       "Configuration",
+						},
+					},
+					{
+						PluginName: "AlwaysPullImages",
+					},
+				},
+			},
+		},
+		{
+			name: "invalid",
+			apiServer: &v1alpha1.APIServerConfig{
+				ExtraArgsConfig: map[string]string{
+					"audit-policy-file":             "/var/policy.yaml",
+					"audit-log-path":                "/var/log/audit.log",
+					"admission-control-config-file": "/var/admission.yaml",
+				},
+				AuditPolicyConfig: map[string]interface{}{
+					"apiVersion": "v1",
+					"kind":       "Policy",
+				},
+				AuditLogConfig: &v1alpha1.AuditLogConfig{
+					AuditLogDestination: "file",
+					AuditLogMaxSize:     -1,
+				},
+				AdmissionControlConfig: []*v1alpha1.AdmissionPluginConfig{
+					{
+						PluginName: "EventRateLimit",
+					},
+					{
+						PluginName: "EventRateLimit",
+					},
+					{},
+				},
+			},
+			expectedError: "7 errors occurred:\n" +
+				"\t* apiserver audit policy should be of kind Policy in the audit.k8s.io group, got \"v1\" \"Policy\"\n" +
+				"\t* apiserver extra arg \"audit-policy-file\" can't be used along with the audit policy config\n" +
+				"\t* apiserver audit log rotation settings should be positive\n" +
+				"\t* apiserver extra arg \"audit-log-path\" can't be used along with the audit log config\n" +
+				"\t* apiserver admission plugin \"EventRateLimit\" is duplicated\n" +
+				"\t* apiserver admission plugin name is required\n" +
+				"\t* apiserver extra arg \"admission-control-config-file\" can't be used along with the admission control config\n" +
+				"\n",
+		},
+		{
+			name: "destination",
+			apiServer: &v1alpha1.APIServerConfig{
+				AuditLogConfig: &v1alpha1.AuditLogConfig{
+					AuditLogDestination: "syslog",
+				},
+			},
+			expectedError: "1 error occurred:\n" +
+				"\t* apiserver audit log destination should be one of [stdout,file]: \"syslog\"\n" +
+				"\n",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.apiServer.Validate()
+
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestManifestSyncValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		sync          *v1alpha1.ManifestSyncConfig
+		expectedError string
+	}{
+		{
+			name: "manifests",
+			sync: &v1alpha1.ManifestSyncConfig{
+				SyncInterval: 5 * time.Minute,
+				SyncManifests: []*v1alpha1.ManifestConfig{
+					{
+						ManifestName:     "namespace",
+						ManifestContents: "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: ci\n",
+					},
+					{
+						ManifestName:   "cni",
+						ManifestURL:    "https://example.com/cni.yaml",
+						ManifestSHA256: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+					},
+				},
+			},
+		},
+		{
+			name: "invalid",
+			sync: &v1alpha1.ManifestSyncConfig{
+				SyncInterval: -time.Minute,
+				SyncManifests: []*v1alpha1.ManifestConfig{
+					{
+						ManifestContents: "---",
+					},
+					{
+						ManifestName: "empty",
+					},
+					{
+						ManifestName:     "empty",
+						ManifestURL:      "https://example.com/cni.yaml",
+						ManifestContents: "---",
+					},
+					{
+						ManifestName:   "cni",
+						ManifestURL:    "example.com/cni.yaml",
+						ManifestSHA256: "9f86d081",
+					},
+				},
+			},
+			expectedError: "7 errors occurred:\n" +
+				"\t* manifest sync interval should be positive: -1m0s\n" +
+				"\t* manifest name is required\n" +
+				"\t* manifest \"empty\" should have either the URL or the contents\n" +
+				"\t* manifest \"empty\" is duplicated\n" +
+				"\t* manifest \"empty\" should have either the URL or the contents\n" +
+				"\t* manifest \"cni\" URL should be an http(s) URL: \"example.com/cni.yaml\"\n" +
+				"\t* manifest \"cni\" should have the SHA-256 checksum of the URL contents\n" +
+				"\n",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.sync.Validate()
+
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestExtraManifestsValidate(t *testing.T) {
+	endpoint, err := url.Parse("https://talos.dev:6443")
+	assert.NoError(t, err)
+
+	const checksum = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+	for _, tt := range []struct {
+		name          string
+		cluster       *v1alpha1.ClusterConfig
+		expectedError string
+	}{
+		{
+			name: "manifests",
+			cluster: &v1alpha1.ClusterConfig{
+				ExtraManifests: []string{"https://example.com/manifest1.yaml", "https://example.com/manifest2.yaml"},
+				ExtraManifestChecksums: map[string]string{
+					"https://example.com/manifest1.yaml": checksum,
+				},
+				ClusterInlineManifests: []*v1alpha1.ManifestConfig{
+					{
+						ManifestName:     "namespace-ci",
+						ManifestContents: "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: ci\n",
+					},
+				},
+			},
+		},
+		{
+			name: "custom CNI",
+			cluster: &v1alpha1.ClusterConfig{
+				ClusterNetwork: &v1alpha1.ClusterNetworkConfig{
+					CNI: &v1alpha1.CNIConfig{
+						CNIName: "custom",
+						CNIUrls: []string{"https://example.com/cni.yaml"},
+					},
+				},
+				ExtraManifestChecksums: map[string]string{
+					"https://example.com/cni.yaml": checksum,
+				},
+				ExtraManifestChecksumsRequired: true,
+			},
+		},
+		{
+			name: "required",
+			cluster: &v1alpha1.ClusterConfig{
+				ExtraManifests: []string{"https://example.com/manifest1.yaml", "https://example.com/manifest2.yaml"},
+				ExtraManifestChecksums: map[string]string{
+					"https://example.com/manifest1.yaml": checksum,
+				},
+				ExtraManifestChecksumsRequired: true,
+			},
+			expectedError: "1 error occurred:\n" +
+				"\t* extra manifest \"https://example.com/manifest2.yaml\" should have the SHA-256 checksum\n" +
+				"\n",
+		},
+		{
+			name: "unknown",
+			cluster: &v1alpha1.ClusterConfig{
+				ExtraManifests: []string{"https://example.com/manifest1.yaml"},
+				ExtraManifestChecksums: map[string]string{
+					"https://example.com/manifest.yaml": "9f86d081",
+				},
+			},
+			expectedError: "2 errors occurred:\n" +
+				"\t* checksum is set for the unknown extra manifest \"https://example.com/manifest.yaml\"\n" +
+				"\t* extra manifest \"https://example.com/manifest.yaml\" checksum should be the SHA-256 checksum in hex: \"9f86d081\"\n" +
+				"\n",
+		},
+		{
+			name: "checksum scheme",
+			cluster: &v1alpha1.ClusterConfig{
+				ExtraManifests: []string{"file:///var/manifests/ci.yaml"},
+				ExtraManifestChecksums: map[string]string{
+					"file:///var/manifests/ci.yaml": checksum,
+				},
+			},
+			expectedError: "1 error occurred:\n" +
+				"\t* extra manifest \"file:///var/manifests/ci.yaml\" with the checksum should be an http(s) URL\n" +
+				"\n",
+		},
+		{
+			name: "inline",
+			cluster: &v1alpha1.ClusterConfig{
+				ClusterInlineManifests: []*v1alpha1.ManifestConfig{
+					{
+						ManifestContents: "---",
+					},
+					{
+						ManifestName:     "../cni",
+						ManifestContents: "---",
+					},
+					{
+						ManifestName:     "cni",
+						ManifestContents: "---",
+					},
+					{
+						ManifestName: "cni",
+					},
+					{
+						ManifestName:     "ingress",
+						ManifestURL:      "https://example.com/ingress.yaml",
+						ManifestContents: "---",
+					},
+				},
+			},
+			expectedError: "5 errors occurred:\n" +
+				"\t* inline manifest name should be a valid file name: \"\"\n" +
+				"\t* inline manifest name should be a valid file name: \"../cni\"\n" +
+				"\t* inline manifest \"cni\" is duplicated\n" +
+				"\t* inline manifest \"cni\" contents are empty\n" +
+				"\t* inline manifest \"ingress\" can't have the URL or the checksum\n" +
+				"\n",
+		},
+		{
+			name: "inline collision",
+			cluster: &v1alpha1.ClusterConfig{
+				ClusterNetwork: &v1alpha1.ClusterNetworkConfig{
+					CNI: &v1alpha1.CNIConfig{
+						CNIName: "custom",
+						CNIUrls: []string{"https://example.com/cni.yaml"},
+					},
+				},
+				ClusterInlineManifests: []*v1alpha1.ManifestConfig{
+					{
+						ManifestName:     "cni",
+						ManifestContents: "---",
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n" +
+				"\t* inline manifest \"cni\" collides with the extra manifest \"https://example.com/cni.yaml\"\n" +
+				"\n",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cluster.ControlPlane = &v1alpha1.ControlPlaneConfig{
+				Endpoint: &v1alpha1.Endpoint{URL: endpoint},
+			}
+
+			err := tt.cluster.Validate()
+
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
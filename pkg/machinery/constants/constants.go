@@ -87,6 +87,20 @@ const (
 	// the root path.
 	RootMountPoint = "/"
 
+	// DefaultUserDiskFilesystem is the filesystem created on the user disk
+	// partitions by default.
+	DefaultUserDiskFilesystem = "xfs"
+
+	// DiskWipeNever sets up the user disk only if it has no partitions.
+	DiskWipeNever = "never"
+
+	// DiskWipeMismatch wipes the user disk if the existing partitions don't
+	// match the configured ones.
+	DiskWipeMismatch = "mismatch"
+
+	// DiskWipeAlways wipes the user disk on every boot.
+	DiskWipeAlways = "always"
+
 	// ISOFilesystemLabel is the label of the ISO file system for the Talos
 	// installer.
 	ISOFilesystemLabel = "TALOS"
@@ -151,6 +165,57 @@ const (
 	// KubernetesAdminCertDefaultLifetime defines default lifetime for Kubernetes generated admin certificate.
 	KubernetesAdminCertDefaultLifetime = 365 * 24 * time.Hour
 
+	// DefaultUpgradeHealthGateTimeout is the default time for an upgraded
+	// node to pass the health gate before it falls back to the previous image.
+	DefaultUpgradeHealthGateTimeout = 10 * time.Minute
+
+	// DefaultUpgradeBootAttempts is the default number of attempts to boot an
+	// upgraded image before falling back to the previous image.
+	DefaultUpgradeBootAttempts = 3
+
+	// DefaultEtcdBackupInterval is the default interval of the scheduled etcd
+	// backups.
+	DefaultEtcdBackupInterval = time.Hour
+
+	// DefaultEtcdBackupRetention is the default number of the etcd backups
+	// kept both locally and in the object storage.
+	DefaultEtcdBackupRetention = 24
+
+	// DefaultEtcdBackupS3Region is the default region of the S3-compatible
+	// storage of the etcd backups.
+	DefaultEtcdBackupS3Region = "us-east-1"
+
+	// DefaultManifestSyncInterval is the default interval of the manifest
+	// sync.
+	DefaultManifestSyncInterval = time.Minute
+
+	// KubernetesAuditLogDir is the directory the apiserver writes the audit
+	// log to if it's written to the file.
+	KubernetesAuditLogDir = "/var/log/audit/kube"
+
+	// KubernetesAPIServerRunUser is the user the apiserver runs as.
+	KubernetesAPIServerRunUser = 65534
+
+	// AuditLogDestinationStdout writes the apiserver audit log to the
+	// apiserver output.
+	AuditLogDestinationStdout = "stdout"
+
+	// AuditLogDestinationFile writes the apiserver audit log to the file in
+	// the KubernetesAuditLogDir.
+	AuditLogDestinationFile = "file"
+
+	// DefaultAuditLogMaxAge is the default number of days to keep the rotated
+	// apiserver audit log files.
+	DefaultAuditLogMaxAge = 30
+
+	// DefaultAuditLogMaxBackup is the default number of the rotated apiserver
+	// audit log files to keep.
+	DefaultAuditLogMaxBackup = 3
+
+	// DefaultAuditLogMaxSize is the default size in megabytes of the apiserver
+	// audit log file before it gets rotated.
+	DefaultAuditLogMaxSize = 50
+
 	// KubeletBootstrapKubeconfig is the path to the kubeconfig required to
 	// bootstrap the kubelet.
 	KubeletBootstrapKubeconfig = "/etc/kubernetes/bootstrap-kubeconfig"
@@ -208,6 +273,13 @@ const (
 	// EtcdDataPath is the path where etcd stores its' data.
 	EtcdDataPath = "/var/lib/etcd"
 
+	// EtcdRecoverySnapshotPath is the path where the snapshot uploaded to
+	// recover etcd is stored until the bootstrap.
+	EtcdRecoverySnapshotPath = "/var/lib/etcd.snapshot"
+
+	// EtcdBackupPath is the path where the scheduled etcd backups are stored.
+	EtcdBackupPath = "/var/lib/etcd-backup"
+
 	// ConfigPath is the path to the downloaded config.
 	ConfigPath = StateMountPoint + "/config.yaml"
 
@@ -241,6 +313,10 @@ const (
 	// SystemContainerdNamespace is the Containerd namespace for Talos services.
 	SystemContainerdNamespace = "system"
 
+	// SystemProcessesCgroup is the parent cgroup of the services run as the
+	// processes on the host.
+	SystemProcessesCgroup = "/system-processes"
+
 	// SystemContainerdAddress is the path to the system containerd socket.
 	SystemContainerdAddress = SystemRunPath + "/containerd/containerd.sock"
 
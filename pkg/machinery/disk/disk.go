@@ -0,0 +1,211 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package disk provides the list of the disks attached to the node and
+// their attributes.
+package disk
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Type represents the type of a disk.
+type Type int
+
+// Disk types.
+const (
+	TypeUnknown Type = iota
+	TypeSSD
+	TypeHDD
+	TypeNVMe
+	TypeSD
+)
+
+// String returns the string representation of the disk type.
+func (t Type) String() string {
+	switch t {
+	case TypeSSD:
+		return "ssd"
+	case TypeHDD:
+		return "hdd"
+	case TypeNVMe:
+		return "nvme"
+	case TypeSD:
+		return "sd"
+	case TypeUnknown:
+		fallthrough
+	default:
+		return "unknown"
+	}
+}
+
+// ParseType parses the disk type from its string representation.
+func ParseType(s string) (Type, error) {
+	switch strings.ToLower(s) {
+	case "ssd":
+		return TypeSSD, nil
+	case "hdd":
+		return TypeHDD, nil
+	case "nvme":
+		return TypeNVMe, nil
+	case "sd":
+		return TypeSD, nil
+	default:
+		return TypeUnknown, fmt.Errorf("unsupported disk type: %q", s)
+	}
+}
+
+// Disk represents a disk attached to the node.
+type Disk struct {
+	// DeviceName is the path to the device node, e.g. /dev/sda.
+	DeviceName string
+	// Size is the size of the disk in bytes.
+	Size uint64
+
+	Model  string
+	Serial string
+	WWN    string
+	Type   Type
+
+	// BusPath is the path of the disk in the device tree, e.g. /pci0000:00/0000:00:1f.2/ata1/host0/target0:0:0/0:0:0:0.
+	BusPath string
+}
+
+// String returns the human readable description of the disk.
+func (d *Disk) String() string {
+	attrs := []string{FormatSize(d.Size), d.Type.String()}
+
+	if d.Model != "" {
+		attrs = append(attrs, fmt.Sprintf("model %q", d.Model))
+	}
+
+	if d.Serial != "" {
+		attrs = append(attrs, fmt.Sprintf("serial %q", d.Serial))
+	}
+
+	if d.WWN != "" {
+		attrs = append(attrs, fmt.Sprintf("wwn %q", d.WWN))
+	}
+
+	if d.BusPath != "" {
+		attrs = append(attrs, fmt.Sprintf("bus path %q", d.BusPath))
+	}
+
+	return fmt.Sprintf("%s (%s)", d.DeviceName, strings.Join(attrs, ", "))
+}
+
+const sysBlock = "/sys/block"
+
+// List returns the disks attached to the node.
+//
+// Partitions, virtual block devices and optical drives are skipped.
+func List() ([]*Disk, error) {
+	return list(sysBlock)
+}
+
+// Probe returns the attributes of the block device. Unlike List, it doesn't
+// skip the virtual block devices.
+func Probe(devpath string) (*Disk, error) {
+	return probe(sysBlock, filepath.Base(devpath))
+}
+
+func list(root string) ([]*Disk, error) {
+	entries, err := ioutil.ReadDir(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list block devices: %w", err)
+	}
+
+	disks := []*Disk{}
+
+	for _, entry := range entries {
+		name := entry.Name()
+
+		// virtual block devices (loop, dm, md, ...) are not backed by a device
+		if _, err = os.Stat(filepath.Join(root, name, "device")); err != nil {
+			continue
+		}
+
+		if strings.HasPrefix(name, "sr") {
+			continue
+		}
+
+		var d *Disk
+
+		if d, err = probe(root, name); err != nil {
+			return nil, err
+		}
+
+		if d.Size == 0 {
+			continue
+		}
+
+		disks = append(disks, d)
+	}
+
+	sort.Slice(disks, func(i, j int) bool { return disks[i].DeviceName < disks[j].DeviceName })
+
+	return disks, nil
+}
+
+func probe(root, name string) (*Disk, error) {
+	d := &Disk{
+		DeviceName: filepath.Join("/dev", name),
+		Model:      readAttribute(root, name, "device/model"),
+		Serial:     readAttribute(root, name, "device/serial"),
+		// the world wide name is reported as the wwid by the SCSI and the
+		// NVMe devices
+		WWN: readAttribute(root, name, "device/wwid"),
+	}
+
+	if d.WWN == "" {
+		d.WWN = readAttribute(root, name, "wwid")
+	}
+
+	if sectors := readAttribute(root, name, "size"); sectors != "" {
+		n, err := strconv.ParseUint(sectors, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse size of %s: %w", name, err)
+		}
+
+		// the size is always reported in 512-byte sectors
+		d.Size = n * 512
+	}
+
+	switch {
+	case strings.HasPrefix(name, "nvme"):
+		d.Type = TypeNVMe
+	case strings.HasPrefix(name, "mmcblk"):
+		d.Type = TypeSD
+	case readAttribute(root, name, "queue/rotational") == "1":
+		d.Type = TypeHDD
+	default:
+		d.Type = TypeSSD
+	}
+
+	// /sys/block/sda -> ../devices/pci0000:00/0000:00:1f.2/ata1/host0/target0:0:0/0:0:0:0/block/sda
+	if link, err := os.Readlink(filepath.Join(root, name)); err == nil {
+		link = strings.TrimSuffix(link, "/block/"+name)
+
+		if i := strings.Index(link, "/devices/"); i != -1 {
+			d.BusPath = link[i+len("/devices"):]
+		}
+	}
+
+	return d, nil
+}
+
+func readAttribute(root, name, attribute string) string {
+	b, err := ioutil.ReadFile(filepath.Join(root, name, attribute))
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(b))
+}
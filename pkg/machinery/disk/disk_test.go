@@ -0,0 +1,155 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint: testpackage
+package disk
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDevice struct {
+	name       string
+	busPath    string
+	sectors    string
+	model      string
+	serial     string
+	rotational string
+}
+
+func setupSysBlock(t *testing.T, devices ...fakeDevice) string {
+	dir, err := ioutil.TempDir("", "talos")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { os.RemoveAll(dir) }) //nolint: errcheck
+
+	root := filepath.Join(dir, "sys", "block")
+
+	require.NoError(t, os.MkdirAll(root, 0o755))
+
+	for _, dev := range devices {
+		target := filepath.Join(dir, "sys", "devices", dev.busPath, "block", dev.name)
+
+		attrs := map[string]string{
+			"size":             dev.sectors,
+			"queue/rotational": dev.rotational,
+		}
+
+		if dev.busPath != "" {
+			attrs["device/model"] = dev.model
+			attrs["device/serial"] = dev.serial
+		}
+
+		for attr, value := range attrs {
+			require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(target, attr)), 0o755))
+			require.NoError(t, ioutil.WriteFile(filepath.Join(target, attr), []byte(value+"\n"), 0o644))
+		}
+
+		rel, err := filepath.Rel(root, target)
+		require.NoError(t, err)
+
+		require.NoError(t, os.Symlink(rel, filepath.Join(root, dev.name)))
+	}
+
+	return root
+}
+
+func TestList(t *testing.T) {
+	root := setupSysBlock(t,
+		fakeDevice{name: "sda", busPath: "pci0000:00/0000:00:1f.2/ata1/host0/target0:0:0/0:0:0:0", sectors: "1953525168", model: "WDC WD10EZEX", serial: "WD-1", rotational: "1"},
+		fakeDevice{name: "nvme0n1", busPath: "pci0000:00/0000:00:1d.0/0000:03:00.0/nvme/nvme0", sectors: "500118192", model: "Samsung SSD 970", serial: "S4", rotational: "0"},
+		fakeDevice{name: "sdb", busPath: "pci0000:00/0000:00:14.0/usb2/2-1/2-1:1.0/host6/target6:0:0/6:0:0:0", sectors: "30031872", model: "Flash Drive", rotational: "0"},
+		fakeDevice{name: "sr0", busPath: "pci0000:00/0000:00:1f.2/ata2/host1/target1:0:0/1:0:0:0", sectors: "2097151", rotational: "1"},
+		fakeDevice{name: "loop0", busPath: "", sectors: "1024", rotational: "0"},
+	)
+
+	disks, err := list(root)
+	require.NoError(t, err)
+	require.Len(t, disks, 3)
+
+	assert.Equal(t, &Disk{
+		DeviceName: "/dev/nvme0n1",
+		Size:       500118192 * 512,
+		Model:      "Samsung SSD 970",
+		Serial:     "S4",
+		Type:       TypeNVMe,
+		BusPath:    "/pci0000:00/0000:00:1d.0/0000:03:00.0/nvme/nvme0",
+	}, disks[0])
+
+	assert.Equal(t, "/dev/sda", disks[1].DeviceName)
+	assert.Equal(t, TypeHDD, disks[1].Type)
+	assert.Equal(t, "/pci0000:00/0000:00:1f.2/ata1/host0/target0:0:0/0:0:0:0", disks[1].BusPath)
+
+	assert.Equal(t, "/dev/sdb", disks[2].DeviceName)
+	assert.Equal(t, TypeSSD, disks[2].Type)
+}
+
+func TestFind(t *testing.T) {
+	disks := []*Disk{
+		{DeviceName: "/dev/sda", Size: 1e12, Model: "WDC WD10EZEX", Type: TypeHDD, BusPath: "/pci0000:00/0000:00:1f.2/ata1"},
+		{DeviceName: "/dev/sdb", Size: 16e9, Model: "Flash Drive", Type: TypeSSD, BusPath: "/pci0000:00/0000:00:14.0/usb2"},
+		{DeviceName: "/dev/nvme0n1", Size: 256e9, Model: "Samsung SSD 970", Type: TypeNVMe},
+	}
+
+	d, err := Find(disks, WithSize(100e9, 0))
+	assert.Nil(t, d)
+	assert.EqualError(t, err, `2 disks match the selector, it should match exactly one: `+
+		`/dev/sda (1.0 TB, hdd, model "WDC WD10EZEX", bus path "/pci0000:00/0000:00:1f.2/ata1"); `+
+		`/dev/nvme0n1 (256.0 GB, nvme, model "Samsung SSD 970")`)
+
+	d, err = Find(disks, WithSize(100e9, 0), WithType(TypeNVMe))
+	require.NoError(t, err)
+	assert.Equal(t, "/dev/nvme0n1", d.DeviceName)
+
+	d, err = Find(disks, WithModel("WDC*"))
+	require.NoError(t, err)
+	assert.Equal(t, "/dev/sda", d.DeviceName)
+
+	d, err = Find(disks, WithBusPath("/pci0000:00/0000:00:14.0/*"))
+	require.NoError(t, err)
+	assert.Equal(t, "/dev/sdb", d.DeviceName)
+
+	_, err = Find(disks, WithSerial("XYZ"))
+	assert.EqualError(t, err, `no disk matches the selector, candidates: `+
+		`/dev/sda (1.0 TB, hdd, model "WDC WD10EZEX", bus path "/pci0000:00/0000:00:1f.2/ata1"); `+
+		`/dev/sdb (16.0 GB, ssd, model "Flash Drive", bus path "/pci0000:00/0000:00:14.0/usb2"); `+
+		`/dev/nvme0n1 (256.0 GB, nvme, model "Samsung SSD 970")`)
+}
+
+func TestMatches(t *testing.T) {
+	d := &Disk{DeviceName: "/dev/sda", Size: 1e12, Model: "WDC WD10EZEX", WWN: "naa.5000c500a0b1c2d3", Type: TypeHDD}
+
+	assert.True(t, Matches(d, WithWWN("naa.5000c500*"), WithType(TypeHDD)))
+	assert.False(t, Matches(d, WithWWN("naa.5000c500*"), WithType(TypeSSD)))
+	assert.True(t, Matches(d))
+}
+
+func TestParseSize(t *testing.T) {
+	for _, tt := range []struct {
+		in       string
+		expected uint64
+	}{
+		{"1024", 1024},
+		{"100GB", 100e9},
+		{"1.5 TB", 1.5e12},
+		{"512GiB", 512 << 30},
+		{"4kib", 4096},
+		{"10b", 10},
+	} {
+		size, err := ParseSize(tt.in)
+		require.NoError(t, err, tt.in)
+		assert.Equal(t, tt.expected, size, tt.in)
+	}
+
+	for _, in := range []string{"", "GB", "-1GB", "10XB"} {
+		_, err := ParseSize(in)
+		assert.Error(t, err, in)
+	}
+}
@@ -0,0 +1,169 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package disk
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Matcher checks whether the disk has the requested attributes.
+type Matcher func(*Disk) bool
+
+// WithSize matches disks with the size in the [min, max] range.
+//
+// Zero max means no upper limit.
+func WithSize(min, max uint64) Matcher {
+	return func(d *Disk) bool {
+		return d.Size >= min && (max == 0 || d.Size <= max)
+	}
+}
+
+// WithModel matches disks by the model using shell pattern.
+func WithModel(pattern string) Matcher {
+	return func(d *Disk) bool {
+		return match(pattern, d.Model)
+	}
+}
+
+// WithSerial matches disks by the serial number using shell pattern.
+func WithSerial(pattern string) Matcher {
+	return func(d *Disk) bool {
+		return match(pattern, d.Serial)
+	}
+}
+
+// WithWWN matches disks by the world wide name using shell pattern.
+func WithWWN(pattern string) Matcher {
+	return func(d *Disk) bool {
+		return match(pattern, d.WWN)
+	}
+}
+
+// WithType matches disks by the type.
+func WithType(t Type) Matcher {
+	return func(d *Disk) bool {
+		return d.Type == t
+	}
+}
+
+// WithBusPath matches disks by the bus path using shell pattern.
+func WithBusPath(pattern string) Matcher {
+	return func(d *Disk) bool {
+		return match(pattern, d.BusPath)
+	}
+}
+
+func match(pattern, value string) bool {
+	matched, err := filepath.Match(pattern, value)
+
+	return err == nil && matched
+}
+
+// Find returns the only disk which satisfies all the matchers.
+//
+// The error lists the candidate disks if no disk or several disks match.
+func Find(disks []*Disk, matchers ...Matcher) (*Disk, error) {
+	var found []*Disk
+
+	for _, d := range disks {
+		if Matches(d, matchers...) {
+			found = append(found, d)
+		}
+	}
+
+	switch len(found) {
+	case 1:
+		return found[0], nil
+	case 0:
+		return nil, fmt.Errorf("no disk matches the selector, candidates: %s", describe(disks))
+	default:
+		return nil, fmt.Errorf("%d disks match the selector, it should match exactly one: %s", len(found), describe(found))
+	}
+}
+
+// Matches checks whether the disk satisfies all the matchers.
+func Matches(d *Disk, matchers ...Matcher) bool {
+	for _, m := range matchers {
+		if !m(d) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func describe(disks []*Disk) string {
+	if len(disks) == 0 {
+		return "<none>"
+	}
+
+	descriptions := make([]string, 0, len(disks))
+
+	for _, d := range disks {
+		descriptions = append(descriptions, d.String())
+	}
+
+	return strings.Join(descriptions, "; ")
+}
+
+var sizeUnits = []struct {
+	suffix     string
+	multiplier uint64
+}{
+	// longer suffixes go first, so that "GiB" is not parsed as "B"
+	{"kib", 1 << 10},
+	{"mib", 1 << 20},
+	{"gib", 1 << 30},
+	{"tib", 1 << 40},
+	{"kb", 1e3},
+	{"mb", 1e6},
+	{"gb", 1e9},
+	{"tb", 1e12},
+	{"b", 1},
+}
+
+// ParseSize parses the size with an optional unit suffix, e.g. 512GB or 1.5TiB.
+func ParseSize(s string) (uint64, error) {
+	value := strings.ToLower(strings.TrimSpace(s))
+	multiplier := uint64(1)
+
+	for _, unit := range sizeUnits {
+		if strings.HasSuffix(value, unit.suffix) {
+			value = strings.TrimSpace(strings.TrimSuffix(value, unit.suffix))
+			multiplier = unit.multiplier
+
+			break
+		}
+	}
+
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid size: %q", s)
+	}
+
+	return uint64(n * float64(multiplier)), nil
+}
+
+// FormatSize returns the size in the human readable form.
+func FormatSize(size uint64) string {
+	for _, unit := range []struct {
+		suffix     string
+		multiplier uint64
+	}{
+		{"TB", 1e12},
+		{"GB", 1e9},
+		{"MB", 1e6},
+		{"kB", 1e3},
+	} {
+		if size >= unit.multiplier {
+			return fmt.Sprintf("%.1f %s", float64(size)/float64(unit.multiplier), unit.suffix)
+		}
+	}
+
+	return fmt.Sprintf("%d B", size)
+}
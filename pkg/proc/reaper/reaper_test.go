@@ -7,6 +7,7 @@ package reaper_test
 import (
 	"os/exec"
 	"sort"
+	"syscall"
 	"testing"
 	"time"
 
@@ -141,8 +142,10 @@ func (suite *ReaperSuite) TestWait() {
 	}
 
 	tests := []struct {
-		args      args
-		errString string
+		args       args
+		errString  string
+		exitStatus int
+		signal     syscall.Signal
 	}{
 		{
 			args{
@@ -150,6 +153,8 @@ func (suite *ReaperSuite) TestWait() {
 				[]string{},
 			},
 			"",
+			0,
+			0,
 		},
 		{
 			args{
@@ -157,6 +162,8 @@ func (suite *ReaperSuite) TestWait() {
 				[]string{},
 			},
 			"exit status 1",
+			1,
+			0,
 		},
 		{
 			args{
@@ -167,6 +174,8 @@ func (suite *ReaperSuite) TestWait() {
 				},
 			},
 			"signal: interrupt",
+			-1,
+			syscall.SIGINT,
 		},
 	}
 
@@ -179,13 +188,20 @@ func (suite *ReaperSuite) TestWait() {
 		cmd := exec.Command(t.args.name, t.args.args...)
 		suite.Require().NoError(cmd.Start())
 
-		err := reaper.WaitWrapper(true, notifyCh, cmd)
+		status, err := reaper.WaitStatusWrapper(true, notifyCh, cmd)
 
 		if t.errString == "" {
 			suite.Assert().NoError(err)
 		} else {
 			suite.Assert().EqualError(err, t.errString)
 		}
+
+		suite.Assert().Equal(t.exitStatus, status.ExitStatus())
+
+		if t.signal != 0 {
+			suite.Assert().True(status.Signaled())
+			suite.Assert().Equal(t.signal, status.Signal())
+		}
 	}
 }
 
@@ -14,8 +14,23 @@ import (
 //
 // WaitWrapper(true, cmd) should be equivalent to cmd.Wait().
 func WaitWrapper(usingReaper bool, notifyCh <-chan ProcessInfo, cmd *exec.Cmd) error {
+	_, err := WaitStatusWrapper(usingReaper, notifyCh, cmd)
+
+	return err
+}
+
+// WaitStatusWrapper is WaitWrapper which also returns process wait status.
+func WaitStatusWrapper(usingReaper bool, notifyCh <-chan ProcessInfo, cmd *exec.Cmd) (syscall.WaitStatus, error) {
 	if !usingReaper {
-		return cmd.Wait()
+		err := cmd.Wait()
+
+		var status syscall.WaitStatus
+
+		if cmd.ProcessState != nil {
+			status, _ = cmd.ProcessState.Sys().(syscall.WaitStatus) //nolint: errcheck
+		}
+
+		return status, err
 	}
 
 	var info ProcessInfo
@@ -34,7 +49,7 @@ func WaitWrapper(usingReaper bool, notifyCh <-chan ProcessInfo, cmd *exec.Cmd) e
 		err = waitErr
 	}
 
-	return err
+	return info.Status, err
 }
 
 func convertWaitStatus(status syscall.WaitStatus) error {